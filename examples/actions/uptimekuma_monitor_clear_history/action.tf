@@ -0,0 +1,18 @@
+# Clear the heartbeats of a monitor after its target has been migrated.
+action "uptimekuma_monitor_clear_history" "api" {
+  config {
+    monitor_id = uptimekuma_monitor_http.api.id
+    events     = false
+  }
+}
+
+resource "terraform_data" "migration" {
+  input = uptimekuma_monitor_http.api.url
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.uptimekuma_monitor_clear_history.api]
+    }
+  }
+}
@@ -0,0 +1,30 @@
+# Pause a monitor for the duration of a deployment.
+action "uptimekuma_monitor_pause" "api" {
+  config {
+    monitor_id = uptimekuma_monitor_http.api.id
+  }
+}
+
+# Resume the monitor once the deployment has finished.
+action "uptimekuma_monitor_pause" "api_resume" {
+  config {
+    monitor_id = uptimekuma_monitor_http.api.id
+    paused     = false
+  }
+}
+
+resource "terraform_data" "deployment" {
+  input = var.release
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.uptimekuma_monitor_pause.api]
+    }
+
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.uptimekuma_monitor_pause.api_resume]
+    }
+  }
+}
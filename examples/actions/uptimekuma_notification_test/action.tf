@@ -0,0 +1,17 @@
+# Send a test message whenever the webhook of the notification changes.
+action "uptimekuma_notification_test" "ops" {
+  config {
+    notification_id = uptimekuma_notification_webhook.ops.id
+  }
+}
+
+resource "terraform_data" "ops_webhook" {
+  input = uptimekuma_notification_webhook.ops.webhook_url
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.uptimekuma_notification_test.ops]
+    }
+  }
+}
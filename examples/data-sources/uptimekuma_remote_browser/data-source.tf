@@ -0,0 +1,10 @@
+# Look up a remote browser managed in the Uptime Kuma UI
+data "uptimekuma_remote_browser" "browserless" {
+  name = "browserless"
+}
+
+resource "uptimekuma_monitor_real_browser" "example" {
+  name           = "Example Website"
+  url            = "https://example.com"
+  remote_browser = data.uptimekuma_remote_browser.browserless.id
+}
@@ -0,0 +1,12 @@
+# Look up a status page managed in another configuration
+data "uptimekuma_status_page" "company" {
+  slug = "company-status"
+}
+
+output "company_status_monitor_ids" {
+  value = flatten([
+    for group in data.uptimekuma_status_page.company.public_group_list : [
+      for monitor in group.monitor_list : monitor.id
+    ]
+  ])
+}
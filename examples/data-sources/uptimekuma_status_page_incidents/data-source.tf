@@ -0,0 +1,13 @@
+# List the incident history of a status page
+data "uptimekuma_status_page_incidents" "example" {
+  status_page_slug = "example-status"
+}
+
+# Output the incidents for reporting
+output "incident_count" {
+  value = length(data.uptimekuma_status_page_incidents.example.incidents)
+}
+
+output "incident_titles" {
+  value = [for i in data.uptimekuma_status_page_incidents.example.incidents : "${i.created_date}: ${i.title}"]
+}
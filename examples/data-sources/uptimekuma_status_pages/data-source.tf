@@ -0,0 +1,6 @@
+# List all status pages
+data "uptimekuma_status_pages" "all" {}
+
+output "published_status_page_slugs" {
+  value = [for sp in data.uptimekuma_status_pages.all.status_pages : sp.slug if sp.published]
+}
@@ -0,0 +1,13 @@
+# Status badge of a monitor.
+output "status_badge" {
+  value = provider::uptimekuma::badge_url("https://uptime.example.com", uptimekuma_monitor_http.api.id, "status", null)
+}
+
+# Uptime badge for the last 30 days.
+output "uptime_badge" {
+  value = provider::uptimekuma::badge_url("https://uptime.example.com", uptimekuma_monitor_http.api.id, "uptime", {
+    duration    = "30d"
+    style       = "flat-square"
+    labelSuffix = " (30 days)"
+  })
+}
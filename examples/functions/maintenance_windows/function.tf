@@ -0,0 +1,16 @@
+# Next four windows of a maintenance every Sunday from 02:00 to 04:00.
+output "maintenance_windows" {
+  value = provider::uptimekuma::maintenance_windows({
+    strategy   = "recurring-weekday"
+    weekdays   = [7]
+    start_time = { hours = 2, minutes = 0, seconds = 0 }
+    end_time   = { hours = 4, minutes = 0, seconds = 0 }
+  }, "Europe/Zurich", timestamp(), 4)
+}
+
+# Upcoming windows of an existing maintenance.
+output "backup_windows" {
+  value = provider::uptimekuma::maintenance_windows(
+    uptimekuma_maintenance.backup, uptimekuma_maintenance.backup.timezone, timestamp(), 10
+  )
+}
@@ -0,0 +1,9 @@
+# Push URL with the default status (up), message (OK) and an empty ping.
+output "push_url" {
+  value = provider::uptimekuma::push_url("https://uptime.example.com", uptimekuma_monitor_push.backup.push_token, null, null, null)
+}
+
+# Push URL reporting a failure with a custom message.
+output "push_url_down" {
+  value = provider::uptimekuma::push_url("https://uptime.example.com", uptimekuma_monitor_push.backup.push_token, "down", "backup failed", null)
+}
@@ -0,0 +1,3 @@
+output "status_page_url" {
+  value = provider::uptimekuma::status_page_url("https://uptime.example.com", uptimekuma_status_page.public.slug)
+}
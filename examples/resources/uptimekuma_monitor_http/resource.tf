@@ -51,3 +51,17 @@ resource "uptimekuma_monitor_http" "with_json_query" {
   active   = true
   method   = "GET"
 }
+
+# Tags can be referenced by name instead of by ID. Missing tags are created, if
+# `auto_create_tags` is enabled in the provider configuration.
+resource "uptimekuma_monitor_http" "with_tags_by_name" {
+  name = "HTTP with Tags by Name"
+  url  = "https://example.com"
+
+  tags = [
+    {
+      name  = "environment"
+      value = "production"
+    },
+  ]
+}
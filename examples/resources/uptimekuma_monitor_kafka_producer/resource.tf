@@ -32,11 +32,12 @@ resource "uptimekuma_monitor_kafka_producer" "sasl" {
   topic   = "uptime-monitor"
   message = "ping"
   ssl     = true
-  sasl_options = jsonencode({
+
+  sasl = {
     mechanism = "scram-sha-512"
     username  = "monitor-user"
     password  = "monitor-password"
-  })
+  }
 
   notification_ids = [1, 2]
 
@@ -47,3 +48,19 @@ resource "uptimekuma_monitor_kafka_producer" "sasl" {
     }
   ]
 }
+
+# Kafka Producer monitor authenticating against Amazon MSK with AWS IAM
+resource "uptimekuma_monitor_kafka_producer" "aws" {
+  name    = "Kafka Producer MSK"
+  brokers = ["b-1.msk.example.com:9098"]
+  topic   = "uptime-monitor"
+  message = "ping"
+  ssl     = true
+
+  sasl = {
+    mechanism              = "aws"
+    authorization_identity = "AIDAEXAMPLE"
+    access_key_id          = "AKIAEXAMPLE"
+    secret_access_key      = "monitor-secret"
+  }
+}
@@ -84,3 +84,20 @@ resource "uptimekuma_monitor_mongodb" "example_with_notification" {
 
   notification_ids = [uptimekuma_notification_slack.alerts.id]
 }
+
+# MongoDB monitor with structured connection attributes
+resource "uptimekuma_monitor_mongodb" "example_structured" {
+  name              = "MongoDB with Structured Connection"
+  database_host     = "db.example.com"
+  database_port     = 27017
+  database_name     = "admin"
+  database_username = "monitoring_user"
+  database_password = "secure_password"
+  database_tls_mode = "verify-full"
+  interval          = 60
+  active            = true
+
+  database_params = {
+    authSource = "admin"
+  }
+}
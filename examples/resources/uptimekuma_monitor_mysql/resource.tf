@@ -73,3 +73,16 @@ resource "uptimekuma_monitor_mysql" "example_with_notification" {
 
   notification_ids = [uptimekuma_notification_slack.alerts.id]
 }
+
+# MySQL monitor with structured connection attributes
+resource "uptimekuma_monitor_mysql" "example_structured" {
+  name              = "MySQL with Structured Connection"
+  database_host     = "db.example.com"
+  database_port     = 3306
+  database_name     = "production"
+  database_username = "app_user"
+  database_password = "secure_password"
+  database_tls_mode = "verify-full"
+  interval          = 60
+  active            = true
+}
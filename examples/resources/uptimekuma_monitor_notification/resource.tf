@@ -0,0 +1,17 @@
+# Attach a notification owned by another team to a monitor
+data "uptimekuma_notification" "oncall" {
+  name = "On-Call"
+}
+
+resource "uptimekuma_monitor_http" "example" {
+  name = "Example Website"
+  url  = "https://example.com"
+
+  # notification_ids is not set, so the notifications attached with
+  # uptimekuma_monitor_notification are kept.
+}
+
+resource "uptimekuma_monitor_notification" "example" {
+  monitor_id      = uptimekuma_monitor_http.example.id
+  notification_id = data.uptimekuma_notification.oncall.id
+}
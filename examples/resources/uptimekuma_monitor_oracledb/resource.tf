@@ -82,3 +82,16 @@ resource "uptimekuma_monitor_oracledb" "example_with_notification" {
 
   notification_ids = [uptimekuma_notification_slack.alerts.id]
 }
+
+# OracleDB monitor with structured connection attributes
+resource "uptimekuma_monitor_oracledb" "example_structured" {
+  name              = "Oracle with Structured Connection"
+  database_host     = "db.example.com"
+  database_port     = 2484
+  database_name     = "PRODDB"
+  database_tls_mode = "require"
+  basic_auth_user   = "monitoring_user"
+  basic_auth_pass   = "secure_password"
+  interval          = 60
+  active            = true
+}
@@ -1,13 +1,20 @@
 resource "uptimekuma_monitor_redis" "example" {
   name              = "Redis Database Monitoring"
-  hostname          = "redis.example.com"
-  port              = 6379
-  database_user     = ""
+  database_host     = "redis.example.com"
+  database_port     = 6379
+  database_name     = "0"
   database_password = "redis_password"
-  database_index    = 0
+  database_tls_mode = "require"
   interval          = 60
-  timeout           = 30
   max_retries       = 2
   upside_down       = false
   active            = true
 }
+
+# Redis monitor with a connection string
+resource "uptimekuma_monitor_redis" "example_connection_string" {
+  name                       = "Redis with Connection String"
+  database_connection_string = "redis://:redis_password@redis.example.com:6379/0"
+  interval                   = 60
+  active                     = true
+}
@@ -25,3 +25,20 @@ resource "uptimekuma_monitor_sqlserver" "with_group" {
 resource "uptimekuma_monitor_group" "databases" {
   name = "Database Monitors"
 }
+
+resource "uptimekuma_monitor_sqlserver" "structured" {
+  name     = "SQL Server with Structured Connection"
+  active   = true
+  interval = 60
+
+  database_host     = "mssql.example.com"
+  database_port     = 1433
+  database_name     = "master"
+  database_username = "sa"
+  database_password = "YourPassword123"
+  database_tls_mode = "verify-full"
+
+  database_params = {
+    "Connection Timeout" = "10"
+  }
+}
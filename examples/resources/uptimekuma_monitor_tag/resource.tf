@@ -0,0 +1,19 @@
+# Stamp a cost center tag onto a monitor owned by another team
+resource "uptimekuma_tag" "cost_center" {
+  name  = "cost_center"
+  color = "#6b7280"
+}
+
+resource "uptimekuma_monitor_http" "example" {
+  name = "Example Website"
+  url  = "https://example.com"
+
+  # tags is not set, so the tags attached with uptimekuma_monitor_tag are
+  # kept.
+}
+
+resource "uptimekuma_monitor_tag" "example" {
+  monitor_id = uptimekuma_monitor_http.example.id
+  tag_id     = uptimekuma_tag.cost_center.id
+  value      = "CC-1234"
+}
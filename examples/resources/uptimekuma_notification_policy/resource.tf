@@ -0,0 +1,21 @@
+# Route the alerts of all monitors by their tier tag, including monitors
+# created later in the Uptime Kuma UI.
+data "uptimekuma_notification" "pagerduty" {
+  name = "PagerDuty"
+}
+
+data "uptimekuma_notification" "slack" {
+  name = "Slack"
+}
+
+resource "uptimekuma_notification_policy" "tier1" {
+  tag_name         = "tier"
+  tag_value        = "1"
+  notification_ids = [data.uptimekuma_notification.pagerduty.id]
+}
+
+resource "uptimekuma_notification_policy" "tier2" {
+  tag_name         = "tier"
+  tag_value        = "2"
+  notification_ids = [data.uptimekuma_notification.slack.id]
+}
@@ -17,3 +17,18 @@ resource "uptimekuma_notification_webhook" "with_headers" {
   is_active  = true
   is_default = false
 }
+
+# Keep the webhook URL out of the Terraform state (Terraform 1.11+). Increment
+# webhook_url_wo_version to send a changed URL to Uptime Kuma.
+variable "webhook_url" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "uptimekuma_notification_webhook" "write_only" {
+  name                   = "Webhook with write-only URL"
+  webhook_url_wo         = var.webhook_url
+  webhook_url_wo_version = 1
+  webhook_content_type   = "json"
+}
@@ -0,0 +1,11 @@
+# A browserless instance used by Real Browser monitors
+resource "uptimekuma_remote_browser" "browserless" {
+  name = "browserless"
+  url  = "ws://browserless:3000/chromium/playwright?token=secret"
+}
+
+resource "uptimekuma_monitor_real_browser" "example" {
+  name           = "Example Website"
+  url            = "https://example.com"
+  remote_browser = uptimekuma_remote_browser.browserless.id
+}
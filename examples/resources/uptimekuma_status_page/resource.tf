@@ -11,6 +11,7 @@ resource "uptimekuma_status_page" "example" {
   published   = true
   show_tags   = true
   theme       = "light"
+  icon_source = "${path.module}/logo.png"
 
   public_group_list = [
     {
@@ -23,6 +24,15 @@ resource "uptimekuma_status_page" "example" {
           url      = "https://example.com"
         }
       ]
+    },
+    {
+      name   = "Public APIs"
+      weight = 2
+      monitor_selector = {
+        tag_name  = "public"
+        tag_value = "api"
+        type      = "http"
+      }
     }
   ]
 }
@@ -0,0 +1,31 @@
+resource "uptimekuma_status_page" "example" {
+  slug      = "company-status"
+  title     = "Company Status"
+  published = true
+
+  # Keep the groups managed by uptimekuma_status_page_group.
+  exclusive_groups = false
+
+  public_group_list = [
+    {
+      name = "Platform"
+    }
+  ]
+}
+
+resource "uptimekuma_monitor_http" "api" {
+  name = "API"
+  url  = "https://api.example.com/health"
+}
+
+# Group of a team, which can be managed in a separate configuration.
+resource "uptimekuma_status_page_group" "api" {
+  status_page_slug = uptimekuma_status_page.example.slug
+  name             = "API Team"
+
+  monitor_list = [
+    {
+      id = uptimekuma_monitor_http.api.id
+    }
+  ]
+}
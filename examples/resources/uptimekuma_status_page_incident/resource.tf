@@ -11,3 +11,13 @@ resource "uptimekuma_status_page_incident" "example" {
   style            = "info"
   pin              = true
 }
+
+# Incident, which is unpinned by the first apply 4 hours after its creation
+resource "uptimekuma_status_page_incident" "outage" {
+  status_page_slug = uptimekuma_status_page.example.slug
+  title            = "Degraded Performance"
+  content          = "Some requests are slower than usual, we are investigating."
+  style            = "warning"
+  pin              = true
+  ttl              = "4h"
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
+	"github.com/breml/terraform-provider-uptimekuma/internal/provider"
+)
+
+// runExport implements the export command, which writes Terraform
+// configuration for all objects of an existing Uptime Kuma instance.
+func runExport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+
+	cfg := client.Config{
+		LogLevel: kuma.LogLevel(os.Getenv("SOCKETIO_LOG_LEVEL")),
+	}
+
+	var (
+		output         string
+		includeSecrets bool
+	)
+
+	flags.StringVar(&cfg.Endpoint, "endpoint", os.Getenv("UPTIMEKUMA_ENDPOINT"),
+		"Uptime Kuma endpoint (env UPTIMEKUMA_ENDPOINT)")
+	flags.StringVar(&cfg.Username, "username", os.Getenv("UPTIMEKUMA_USERNAME"),
+		"Uptime Kuma username (env UPTIMEKUMA_USERNAME)")
+	flags.StringVar(&cfg.Password, "password", os.Getenv("UPTIMEKUMA_PASSWORD"),
+		"Uptime Kuma password (env UPTIMEKUMA_PASSWORD)")
+	flags.DurationVar(&cfg.ConnectTimeout, "timeout", envDuration("UPTIMEKUMA_TIMEOUT"),
+		"overall connection timeout (env UPTIMEKUMA_TIMEOUT)")
+	flags.DurationVar(&cfg.PerAttemptTimeout, "per-attempt-timeout", envDuration("UPTIMEKUMA_PER_ATTEMPT_TIMEOUT"),
+		"per-attempt connection timeout (env UPTIMEKUMA_PER_ATTEMPT_TIMEOUT)")
+	flags.IntVar(&cfg.MaxRetries, "max-retries", 3, "maximum number of connection retry attempts")
+	flags.StringVar(&output, "output", "-", "file to write the configuration to, - for stdout")
+	flags.BoolVar(&includeSecrets, "include-secrets", false,
+		"write sensitive values into the configuration instead of generating sensitive variables")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return fmt.Errorf("parse arguments: %w", err)
+	}
+
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint is required, use -endpoint or UPTIMEKUMA_ENDPOINT")
+	}
+
+	kumaClient, err := client.New(ctx, &cfg)
+	if err != nil {
+		return fmt.Errorf("connect to Uptime Kuma at %q: %w", cfg.Endpoint, err)
+	}
+
+	defer func() {
+		_ = kumaClient.Disconnect()
+	}()
+
+	var w io.Writer = os.Stdout
+
+	if output != "-" {
+		f, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("create output file: %w", err)
+		}
+
+		defer func() {
+			err := f.Close()
+			if err != nil {
+				log.Printf("close output file: %v", err)
+			}
+		}()
+
+		w = f
+	}
+
+	err = provider.Export(ctx, kumaClient, w, provider.ExportOptions{IncludeSecrets: includeSecrets})
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+
+	return nil
+}
+
+// envDuration returns the duration parsed from the environment variable, or
+// zero if the variable is unset or invalid.
+func envDuration(name string) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(name))
+	if err != nil {
+		return 0
+	}
+
+	return d
+}
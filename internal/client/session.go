@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	socketio "github.com/maldikhan/go.socket.io/socket.io/v5/client"
+	"github.com/maldikhan/go.socket.io/socket.io/v5/client/emit"
+	"github.com/maldikhan/go.socket.io/utils"
+	"golang.org/x/sync/errgroup"
+)
+
+// Ack is the acknowledgement Uptime Kuma sends in response to an event.
+type Ack struct {
+	OK  bool   `json:"ok"`
+	Msg string `json:"msg"`
+}
+
+// Session is a plain Socket.IO connection to Uptime Kuma. It is used for
+// server events, which are not (yet) supported by go-uptime-kuma-client.
+// In contrast to the client returned by New, a session does not wait for
+// the initial object lists and is therefore cheap to establish. It is meant
+// to be short-lived and must be closed after use.
+type Session struct {
+	socketioClient *socketio.Client
+}
+
+// SessionOption configures a Session.
+type SessionOption func(*Session)
+
+// WithEventHandler registers a handler for a server event before the session
+// logs in. This allows to receive the object lists, which Uptime Kuma sends
+// right after the login. The arguments of the handler are decoded from JSON.
+func WithEventHandler(event string, handler any) SessionOption {
+	return func(s *Session) {
+		s.socketioClient.On(event, handler)
+	}
+}
+
+// NewSession connects to Uptime Kuma and logs in with the credentials from
+// config. The connection process is bounded by config.ConnectTimeout.
+func NewSession(ctx context.Context, config *Config, opts ...SessionOption) (*Session, error) {
+	if config.Endpoint == "" {
+		return nil, errors.New("endpoint is required")
+	}
+
+	logLevel := config.LogLevel
+	if logLevel < utils.DEBUG || logLevel > utils.NONE {
+		logLevel = utils.NONE
+	}
+
+	socketioClient, err := socketio.NewClient(
+		socketio.WithRawURL(config.Endpoint),
+		socketio.WithLogger(&utils.DefaultLogger{Level: logLevel}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create socketio client: %w", err)
+	}
+
+	s := &Session{socketioClient: socketioClient}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, effectiveTimeout(config.ConnectTimeout))
+	defer cancel()
+
+	err = s.connect(ctx, connectCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Username == "" && config.Password == "" {
+		return s, nil
+	}
+
+	_, err = s.Emit(connectCtx, "login", map[string]any{
+		"username": config.Username,
+		"password": config.Password,
+		"token":    "",
+	})
+	if err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// connect establishes the Socket.IO connection. The connection lifetime is
+// bound to ctx, while connectCtx limits the time to wait for the connection
+// to be established.
+func (s *Session) connect(ctx context.Context, connectCtx context.Context) error {
+	connected := make(chan struct{})
+	closeConnected := sync.OnceFunc(func() {
+		close(connected)
+	})
+
+	s.socketioClient.On("connect", func() {
+		closeConnected()
+	})
+
+	errgrp := errgroup.Group{}
+	errgrp.Go(func() error {
+		return s.socketioClient.Connect(ctx)
+	})
+
+	select {
+	case <-connected:
+	case <-connectCtx.Done():
+		// Closing the client while it is still connecting is not safe, so
+		// the connection is closed as soon as the attempt completes.
+		go func() {
+			if errgrp.Wait() == nil {
+				_ = s.socketioClient.Close()
+			}
+		}()
+
+		return fmt.Errorf("connect to server: %w", connectCtx.Err())
+	}
+
+	err := errgrp.Wait()
+	if err != nil {
+		return fmt.Errorf("connect to server: %w", err)
+	}
+
+	return nil
+}
+
+// Emit sends the event with the given arguments to Uptime Kuma and waits for
+// the acknowledgement. An error is returned if the acknowledgement reports
+// a failure.
+func (s *Session) Emit(ctx context.Context, event string, args ...any) (Ack, error) {
+	return s.Call(ctx, event, nil, args...)
+}
+
+// Call works like Emit, but additionally decodes the acknowledgement into
+// result, e.g. to get the ID of a created object. Result is ignored if nil.
+func (s *Session) Call(ctx context.Context, event string, result any, args ...any) (Ack, error) {
+	res := make(chan json.RawMessage, 1)
+
+	args = append(args, emit.WithAck(func(raw json.RawMessage) {
+		res <- raw
+	}))
+
+	err := s.socketioClient.Emit(event, args...)
+	if err != nil {
+		return Ack{}, fmt.Errorf("%s: %w", event, err)
+	}
+
+	select {
+	case raw := <-res:
+		var ack Ack
+
+		err = json.Unmarshal(raw, &ack)
+		if err != nil {
+			return Ack{}, fmt.Errorf("%s: decode acknowledgement: %w", event, err)
+		}
+
+		if !ack.OK {
+			return ack, fmt.Errorf("%s: %s", event, ack.Msg)
+		}
+
+		if result != nil {
+			err = json.Unmarshal(raw, result)
+			if err != nil {
+				return ack, fmt.Errorf("%s: decode acknowledgement: %w", event, err)
+			}
+		}
+
+		return ack, nil
+
+	case <-ctx.Done():
+		return Ack{}, fmt.Errorf("%s: %w", event, ctx.Err())
+	}
+}
+
+// Close closes the connection to Uptime Kuma.
+func (s *Session) Close() error {
+	err := s.socketioClient.Close()
+	if err != nil {
+		return fmt.Errorf("close socket.io client: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+func TestNewSession_EmptyEndpoint(t *testing.T) {
+	_, err := NewSession(t.Context(), &Config{})
+	if err == nil {
+		t.Fatal("expected error for empty endpoint, got nil")
+	}
+}
+
+func TestNewSession_ConnectTimeout(t *testing.T) {
+	endpoint := startDeadEndListener(t)
+	connectTimeout := 1 * time.Second
+
+	config := &Config{
+		Endpoint:       endpoint,
+		Username:       "admin",
+		Password:       "secret",
+		ConnectTimeout: connectTimeout,
+		LogLevel:       kuma.LogLevel(os.Getenv("SOCKETIO_LOG_LEVEL")),
+	}
+
+	start := time.Now()
+
+	_, err := NewSession(t.Context(), config)
+
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected error for unreachable endpoint, got nil")
+	}
+
+	upperBound := connectTimeout + 2*time.Second
+	if elapsed > upperBound {
+		t.Errorf("expected session to fail within %s, took %s", upperBound, elapsed)
+	}
+}
@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrStatusPageNotFound is returned, if the requested status page does not
+// exist.
+var ErrStatusPageNotFound = errors.New("status page not found")
+
+// ErrStatusPageIconNotFound is returned, if the requested icon of a status
+// page does not exist.
+var ErrStatusPageIconNotFound = errors.New("status page icon not found")
+
+// Incident is an incident as returned by the public status page API of
+// Uptime Kuma. The dates are in UTC in the format `2006-01-02 15:04:05`.
+type Incident struct {
+	ID              int64  `json:"id"`
+	Title           string `json:"title"`
+	Content         string `json:"content"`
+	Style           string `json:"style"`
+	Pin             bool   `json:"pin"`
+	CreatedDate     string `json:"createdDate"`
+	LastUpdatedDate string `json:"lastUpdatedDate"`
+}
+
+// PublicGroup is a group of monitors on a status page as returned by the
+// public status page API of Uptime Kuma.
+type PublicGroup struct {
+	ID          int64           `json:"id"`
+	Name        string          `json:"name"`
+	Weight      int64           `json:"weight"`
+	MonitorList []PublicMonitor `json:"monitorList"`
+}
+
+// PublicMonitor is a monitor of a PublicGroup. URL is only set, if SendURL is
+// true.
+type PublicMonitor struct {
+	ID      int64  `json:"id"`
+	SendURL bool   `json:"sendUrl"`
+	URL     string `json:"url"`
+}
+
+// StatusPageConfig holds the settings of a status page, which are only
+// available through the public status page API.
+type StatusPageConfig struct {
+	// AutoRefreshInterval is the refresh interval of the status page in
+	// seconds. It is only set by Uptime Kuma 2.x.
+	AutoRefreshInterval *int64 `json:"autoRefreshInterval"`
+}
+
+// StatusPageData is the public data of a status page.
+type StatusPageData struct {
+	Config          StatusPageConfig `json:"config"`
+	Incident        *Incident        `json:"incident"`
+	PublicGroupList []PublicGroup    `json:"publicGroupList"`
+}
+
+// GetStatusPageData returns the public data of the status page with the given
+// slug. It uses the public status page API, because the pinned incident, the
+// groups and some settings of the status page are not available through
+// Socket.IO. The request is bounded by config.ConnectTimeout.
+func GetStatusPageData(ctx context.Context, config *Config, slug string) (*StatusPageData, error) {
+	var body StatusPageData
+
+	err := getStatusPage(ctx, config, slug, "", nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}
+
+// GetStatusPageIncident returns the incident currently pinned on the status
+// page with the given slug, or nil if there is none.
+func GetStatusPageIncident(ctx context.Context, config *Config, slug string) (*Incident, error) {
+	data, err := GetStatusPageData(ctx, config, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.Incident, nil
+}
+
+// GetStatusPageIncidentHistory returns all incidents of the status page with
+// the given slug, newest first. The incident history is only available with
+// Uptime Kuma 2.x. Each page of the history is bounded by
+// config.ConnectTimeout.
+func GetStatusPageIncidentHistory(ctx context.Context, config *Config, slug string) ([]Incident, error) {
+	var (
+		incidents []Incident
+		cursor    string
+	)
+
+	for {
+		query := url.Values{}
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+
+		var body struct {
+			OK         bool       `json:"ok"`
+			Msg        string     `json:"msg"`
+			Incidents  []Incident `json:"incidents"`
+			NextCursor string     `json:"nextCursor"`
+			HasMore    bool       `json:"hasMore"`
+		}
+
+		err := getStatusPage(ctx, config, slug, "/incident-history", query, &body)
+		if err != nil {
+			return nil, err
+		}
+
+		if !body.OK {
+			return nil, fmt.Errorf("get incident history of status page %s: %s", slug, body.Msg)
+		}
+
+		incidents = append(incidents, body.Incidents...)
+
+		if !body.HasMore || body.NextCursor == "" || body.NextCursor == cursor {
+			return incidents, nil
+		}
+
+		cursor = body.NextCursor
+	}
+}
+
+// GetStatusPageIcon returns the content of the icon of a status page. iconPath
+// is the icon as reported by the status page, e.g. `/upload/logo1.png?t=1`.
+// The request is bounded by config.ConnectTimeout.
+func GetStatusPageIcon(ctx context.Context, config *Config, iconPath string) ([]byte, error) {
+	if config.Endpoint == "" {
+		return nil, errors.New("endpoint is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, effectiveTimeout(config.ConnectTimeout))
+	defer cancel()
+
+	reqURL := strings.TrimSuffix(config.Endpoint, "/") + "/" + strings.TrimPrefix(iconPath, "/")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get status page icon %s: %w", iconPath, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("get status page icon %s: %w", iconPath, ErrStatusPageIconNotFound)
+	default:
+		return nil, fmt.Errorf("get status page icon %s: unexpected status %s", iconPath, resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("get status page icon %s: read response: %w", iconPath, err)
+	}
+
+	return content, nil
+}
+
+// getStatusPage gets the resource with the given suffix of the public API of
+// the status page with the given query and decodes the JSON response into
+// result. Uptime Kuma caches the responses of the public API, so a unique
+// query parameter is added to get the current state.
+func getStatusPage(
+	ctx context.Context,
+	config *Config,
+	slug string,
+	suffix string,
+	query url.Values,
+	result any,
+) error {
+	if config.Endpoint == "" {
+		return errors.New("endpoint is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, effectiveTimeout(config.ConnectTimeout))
+	defer cancel()
+
+	values := url.Values{}
+	for key, value := range query {
+		values[key] = value
+	}
+
+	values.Set("_", strconv.FormatInt(time.Now().UnixNano(), 10))
+
+	reqURL := strings.TrimSuffix(config.Endpoint, "/") + "/api/status-page/" + url.PathEscape(slug) + suffix +
+		"?" + values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("get status page %s: %w", slug, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return fmt.Errorf("get status page %s: %w", slug, ErrStatusPageNotFound)
+	default:
+		return fmt.Errorf("get status page %s: unexpected status %s", slug, resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(result)
+	if err != nil {
+		return fmt.Errorf("get status page %s: decode response: %w", slug, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestGetStatusPageIncident(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("_") == "" {
+			t.Errorf("request %s does not bypass the cache", r.URL)
+		}
+
+		switch r.URL.Path {
+		case "/api/status-page/with-incident":
+			_, _ = w.Write([]byte(`{"config":{},"incident":{"id":7,"title":"Outage","content":"Down","style":"danger",` +
+				`"pin":true,"createdDate":"2026-01-02 03:04:05","lastUpdatedDate":null}}`))
+		case "/api/status-page/without-incident":
+			_, _ = w.Write([]byte(`{"config":{},"incident":null}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{Endpoint: server.URL + "/"}
+
+	incident, err := GetStatusPageIncident(t.Context(), config, "with-incident")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := Incident{
+		ID:          7,
+		Title:       "Outage",
+		Content:     "Down",
+		Style:       "danger",
+		Pin:         true,
+		CreatedDate: "2026-01-02 03:04:05",
+	}
+	if incident == nil || *incident != want {
+		t.Fatalf("incident = %+v, want %+v", incident, want)
+	}
+
+	incident, err = GetStatusPageIncident(t.Context(), config, "without-incident")
+	if err != nil || incident != nil {
+		t.Fatalf("GetStatusPageIncident() = %+v, %v, want nil, nil", incident, err)
+	}
+
+	_, err = GetStatusPageIncident(t.Context(), config, "missing")
+	if !errors.Is(err, ErrStatusPageNotFound) {
+		t.Fatalf("error = %v, want %v", err, ErrStatusPageNotFound)
+	}
+}
+
+func TestGetStatusPageData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("_") == "" {
+			t.Errorf("request %s does not bypass the cache", r.URL)
+		}
+
+		_, _ = w.Write([]byte(`{"config":{"autoRefreshInterval":300},"incident":null,"publicGroupList":[` +
+			`{"id":1,"name":"Services","weight":1,"monitorList":[` +
+			`{"id":3,"name":"Web","sendUrl":true,"url":"https://example.com","type":"http"},` +
+			`{"id":4,"name":"DB","type":"postgres"}]},` +
+			`{"id":2,"name":"Other","weight":2,"monitorList":[]}]}`))
+	}))
+	defer server.Close()
+
+	data, err := GetStatusPageData(t.Context(), &Config{Endpoint: server.URL}, "with-groups")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []PublicGroup{
+		{
+			ID:     1,
+			Name:   "Services",
+			Weight: 1,
+			MonitorList: []PublicMonitor{
+				{ID: 3, SendURL: true, URL: "https://example.com"},
+				{ID: 4},
+			},
+		},
+		{ID: 2, Name: "Other", Weight: 2, MonitorList: []PublicMonitor{}},
+	}
+	if data.Config.AutoRefreshInterval == nil || *data.Config.AutoRefreshInterval != 300 {
+		t.Errorf("auto refresh interval = %v, want 300", data.Config.AutoRefreshInterval)
+	}
+
+	if data.Incident != nil || !reflect.DeepEqual(data.PublicGroupList, want) {
+		t.Fatalf("GetStatusPageData() = %+v, want groups %+v", data, want)
+	}
+}
+
+func TestGetStatusPageIcon(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/upload/logo1.png":
+			_, _ = w.Write([]byte("png"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{Endpoint: server.URL + "/"}
+
+	content, err := GetStatusPageIcon(t.Context(), config, "/upload/logo1.png?t=1")
+	if err != nil || string(content) != "png" {
+		t.Fatalf("GetStatusPageIcon() = %q, %v, want %q, nil", content, err, "png")
+	}
+
+	_, err = GetStatusPageIcon(t.Context(), config, "/upload/logo2.png")
+	if !errors.Is(err, ErrStatusPageIconNotFound) {
+		t.Fatalf("error = %v, want %v", err, ErrStatusPageIconNotFound)
+	}
+}
+
+func TestGetStatusPageIncidentHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path + "?cursor=" + r.URL.Query().Get("cursor") {
+		case "/api/status-page/history/incident-history?cursor=":
+			_, _ = w.Write([]byte(`{"ok":true,"incidents":[{"id":3,"title":"Third","pin":true}],` +
+				`"nextCursor":"2026-01-03 00:00:00","hasMore":true}`))
+		case "/api/status-page/history/incident-history?cursor=2026-01-03 00:00:00":
+			_, _ = w.Write([]byte(`{"ok":true,"incidents":[{"id":2,"title":"Second"},{"id":1,"title":"First"}],` +
+				`"nextCursor":null,"hasMore":false}`))
+		case "/api/status-page/failing/incident-history?cursor=":
+			_, _ = w.Write([]byte(`{"ok":false,"msg":"boom"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{Endpoint: server.URL}
+
+	incidents, err := GetStatusPageIncidentHistory(t.Context(), config, "history")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Incident{
+		{ID: 3, Title: "Third", Pin: true},
+		{ID: 2, Title: "Second"},
+		{ID: 1, Title: "First"},
+	}
+	if !slices.Equal(incidents, want) {
+		t.Fatalf("incidents = %+v, want %+v", incidents, want)
+	}
+
+	_, err = GetStatusPageIncidentHistory(t.Context(), config, "failing")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	_, err = GetStatusPageIncidentHistory(t.Context(), config, "missing")
+	if !errors.Is(err, ErrStatusPageNotFound) {
+		t.Fatalf("error = %v, want %v", err, ErrStatusPageNotFound)
+	}
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = &MonitorClearHistoryAction{}
+	_ action.ActionWithConfigure      = &MonitorClearHistoryAction{}
+	_ action.ActionWithValidateConfig = &MonitorClearHistoryAction{}
+)
+
+// NewMonitorClearHistoryAction returns a new instance of the monitor clear history action.
+func NewMonitorClearHistoryAction() action.Action {
+	return &MonitorClearHistoryAction{}
+}
+
+// MonitorClearHistoryAction defines the action implementation.
+type MonitorClearHistoryAction struct {
+	providerData *providerData
+}
+
+// MonitorClearHistoryActionModel describes the action data model.
+type MonitorClearHistoryActionModel struct {
+	MonitorID  types.Int64 `tfsdk:"monitor_id"`
+	Heartbeats types.Bool  `tfsdk:"heartbeats"`
+	Events     types.Bool  `tfsdk:"events"`
+}
+
+// Metadata returns the metadata for the action.
+func (*MonitorClearHistoryAction) Metadata(
+	_ context.Context,
+	req action.MetadataRequest,
+	resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_clear_history"
+}
+
+// Schema returns the schema for the action.
+func (*MonitorClearHistoryAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Clears the heartbeat and/or event history of a monitor, " +
+			"e.g. after a migration. This operation cannot be undone.",
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the monitor to clear the history for",
+				Required:            true,
+			},
+			"heartbeats": schema.BoolAttribute{
+				MarkdownDescription: "Clear all heartbeats of the monitor (default: `true`)",
+				Optional:            true,
+			},
+			"events": schema.BoolAttribute{
+				MarkdownDescription: "Clear the important events of the monitor (default: `true`)",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the action with the provider data.
+func (a *MonitorClearHistoryAction) Configure(
+	_ context.Context,
+	req action.ConfigureRequest,
+	resp *action.ConfigureResponse,
+) {
+	a.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// ValidateConfig ensures that at least one kind of history is cleared.
+func (*MonitorClearHistoryAction) ValidateConfig(
+	ctx context.Context,
+	req action.ValidateConfigRequest,
+	resp *action.ValidateConfigResponse,
+) {
+	var data MonitorClearHistoryActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isExplicitFalse(data.Heartbeats) && isExplicitFalse(data.Events) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("heartbeats"),
+			"nothing to clear",
+			"At least one of heartbeats or events must be true.",
+		)
+	}
+}
+
+// Invoke clears the history of the monitor.
+func (a *MonitorClearHistoryAction) Invoke(
+	ctx context.Context,
+	req action.InvokeRequest,
+	resp *action.InvokeResponse,
+) {
+	var data MonitorClearHistoryActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	session, err := a.providerData.openSession(ctx)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError("failed to connect to Uptime Kuma", err.Error())
+		return
+	}
+
+	defer func() {
+		_ = session.Close()
+	}()
+
+	monitorID := data.MonitorID.ValueInt64()
+
+	if !isExplicitFalse(data.Heartbeats) {
+		_, err = session.Emit(ctx, "clearHeartbeats", monitorID)
+		// Handle error.
+		if err != nil {
+			resp.Diagnostics.AddError("failed to clear heartbeats", err.Error())
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Cleared heartbeats of monitor %d", monitorID),
+		})
+	}
+
+	if !isExplicitFalse(data.Events) {
+		_, err = session.Emit(ctx, "clearEvents", monitorID)
+		// Handle error.
+		if err != nil {
+			resp.Diagnostics.AddError("failed to clear events", err.Error())
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Cleared events of monitor %d", monitorID),
+		})
+	}
+}
+
+// isExplicitFalse reports whether the value is known and set to false.
+func isExplicitFalse(value types.Bool) bool {
+	return !value.IsNull() && !value.IsUnknown() && !value.ValueBool()
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccMonitorClearHistoryAction(t *testing.T) {
+	monitorName := acctest.RandomWithPrefix("TestMonitorClearHistoryAction")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMonitorClearHistoryActionConfig(monitorName, false),
+				ExpectError: regexp.MustCompile("nothing to clear"),
+			},
+			{
+				Config: testAccMonitorClearHistoryActionConfig(monitorName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("terraform_data.migration", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMonitorClearHistoryActionConfig(name string, heartbeats bool) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_http" "test" {
+  name = %[1]q
+  url  = "https://example.com"
+}
+
+action "uptimekuma_monitor_clear_history" "test" {
+  config {
+    monitor_id = uptimekuma_monitor_http.test.id
+    heartbeats = %[2]t
+    events     = false
+  }
+}
+
+resource "terraform_data" "migration" {
+  input = uptimekuma_monitor_http.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.uptimekuma_monitor_clear_history.test]
+    }
+  }
+}
+`, name, heartbeats)
+}
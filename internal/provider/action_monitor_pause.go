@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+var (
+	_ action.Action              = &MonitorPauseAction{}
+	_ action.ActionWithConfigure = &MonitorPauseAction{}
+)
+
+// NewMonitorPauseAction returns a new instance of the monitor pause action.
+func NewMonitorPauseAction() action.Action {
+	return &MonitorPauseAction{}
+}
+
+// MonitorPauseAction defines the action implementation.
+type MonitorPauseAction struct {
+	client *kuma.Client
+}
+
+// MonitorPauseActionModel describes the action data model.
+type MonitorPauseActionModel struct {
+	MonitorID types.Int64 `tfsdk:"monitor_id"`
+	Paused    types.Bool  `tfsdk:"paused"`
+}
+
+// Metadata returns the metadata for the action.
+func (*MonitorPauseAction) Metadata(
+	_ context.Context,
+	req action.MetadataRequest,
+	resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_pause"
+}
+
+// Schema returns the schema for the action.
+func (*MonitorPauseAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pauses or resumes a monitor. " +
+			"Pausing a monitor suspends its checks and notifications, e.g. for the duration of a deployment.",
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the monitor to pause or resume",
+				Required:            true,
+			},
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "Pause the monitor when `true` (default), resume it when `false`",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the action with the API client.
+func (a *MonitorPauseAction) Configure(
+	_ context.Context,
+	req action.ConfigureRequest,
+	resp *action.ConfigureResponse,
+) {
+	a.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Invoke pauses or resumes the monitor.
+func (a *MonitorPauseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data MonitorPauseActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := data.MonitorID.ValueInt64()
+
+	if data.Paused.IsNull() || data.Paused.ValueBool() {
+		err := a.client.PauseMonitor(ctx, monitorID)
+		// Handle error.
+		if err != nil {
+			resp.Diagnostics.AddError("failed to pause monitor", err.Error())
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Paused monitor %d", monitorID)})
+
+		return
+	}
+
+	err := a.client.ResumeMonitor(ctx, monitorID)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError("failed to resume monitor", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Resumed monitor %d", monitorID)})
+}
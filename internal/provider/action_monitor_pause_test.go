@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccMonitorPauseAction(t *testing.T) {
+	monitorName := acctest.RandomWithPrefix("TestMonitorPauseAction")
+	kumaClient := testAccOutOfBandClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorPauseActionConfig(monitorName),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["uptimekuma_monitor_http.test"]
+					if !ok {
+						return fmt.Errorf("resource not found in state: uptimekuma_monitor_http.test")
+					}
+
+					id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid monitor ID %q: %w", rs.Primary.ID, err)
+					}
+
+					mon, err := kumaClient.GetMonitor(t.Context(), id)
+					if err != nil {
+						return fmt.Errorf("failed to get monitor %d: %w", id, err)
+					}
+
+					if mon.IsActive {
+						return fmt.Errorf("expected monitor %d to be paused", id)
+					}
+
+					return nil
+				},
+				// The monitor is paused outside of its resource, which shows up as drift on active.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccMonitorPauseActionConfig(name string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_http" "test" {
+  name = %[1]q
+  url  = "https://example.com"
+}
+
+action "uptimekuma_monitor_pause" "test" {
+  config {
+    monitor_id = uptimekuma_monitor_http.test.id
+  }
+}
+
+resource "terraform_data" "deployment" {
+  input = uptimekuma_monitor_http.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.uptimekuma_monitor_pause.test]
+    }
+  }
+}
+`, name)
+}
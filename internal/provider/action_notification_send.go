@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+var (
+	_ action.Action              = &NotificationTestAction{}
+	_ action.ActionWithConfigure = &NotificationTestAction{}
+)
+
+// NewNotificationTestAction returns a new instance of the notification test action.
+func NewNotificationTestAction() action.Action {
+	return &NotificationTestAction{}
+}
+
+// NotificationTestAction defines the action implementation.
+type NotificationTestAction struct {
+	providerData *providerData
+}
+
+// NotificationTestActionModel describes the action data model.
+type NotificationTestActionModel struct {
+	NotificationID types.Int64 `tfsdk:"notification_id"`
+}
+
+// Metadata returns the metadata for the action.
+func (*NotificationTestAction) Metadata(
+	_ context.Context,
+	req action.MetadataRequest,
+	resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_notification_test"
+}
+
+// Schema returns the schema for the action.
+func (*NotificationTestAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sends a test message through a notification, " +
+			"e.g. to verify a notification after rotating its credentials.",
+		Attributes: map[string]schema.Attribute{
+			"notification_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the notification to test",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the action with the provider data.
+func (a *NotificationTestAction) Configure(
+	_ context.Context,
+	req action.ConfigureRequest,
+	resp *action.ConfigureResponse,
+) {
+	a.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Invoke sends a test message with the stored configuration of the notification.
+func (a *NotificationTestAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data NotificationTestActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationID := data.NotificationID.ValueInt64()
+
+	base, err := a.providerData.client.GetNotification(ctx, notificationID)
+	// Handle error.
+	if err != nil {
+		if errors.Is(err, kuma.ErrNotFound) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("notification_id"),
+				"notification not found",
+				fmt.Sprintf("Notification with ID %d does not exist.", notificationID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to read notification", err.Error())
+		return
+	}
+
+	session, err := a.providerData.openSession(ctx)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError("failed to connect to Uptime Kuma", err.Error())
+		return
+	}
+
+	defer func() {
+		_ = session.Close()
+	}()
+
+	ack, err := session.Emit(ctx, "testNotification", base)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError("failed to send test notification", err.Error())
+		return
+	}
+
+	message := fmt.Sprintf("Sent test notification through %q", base.Name)
+	if ack.Msg != "" {
+		message += ": " + ack.Msg
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNotificationTestAction(t *testing.T) {
+	notificationName := acctest.RandomWithPrefix("TestNotificationTestAction")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The webhook target is unreachable, so Uptime Kuma reports the failed delivery.
+				Config:      testAccNotificationTestActionConfig(notificationName),
+				ExpectError: regexp.MustCompile("failed to send test notification"),
+			},
+		},
+	})
+}
+
+func testAccNotificationTestActionConfig(name string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_notification_webhook" "test" {
+  name                 = %[1]q
+  webhook_url          = "http://127.0.0.1:1/hook"
+  webhook_content_type = "json"
+}
+
+action "uptimekuma_notification_test" "test" {
+  config {
+    notification_id = uptimekuma_notification_webhook.test.id
+  }
+}
+
+resource "terraform_data" "rotation" {
+  input = uptimekuma_notification_webhook.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.uptimekuma_notification_test.test]
+    }
+  }
+}
+`, name)
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RemoteBrowserDataSource{}
+
+// NewRemoteBrowserDataSource returns a new instance of the remote browser data source.
+func NewRemoteBrowserDataSource() datasource.DataSource {
+	return &RemoteBrowserDataSource{}
+}
+
+// RemoteBrowserDataSource manages remote browser data source operations.
+type RemoteBrowserDataSource struct {
+	providerData *providerData
+}
+
+// RemoteBrowserDataSourceModel describes the data model for remote browser data source.
+type RemoteBrowserDataSourceModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	URL  types.String `tfsdk:"url"`
+}
+
+// Metadata returns the metadata for the data source.
+func (*RemoteBrowserDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_remote_browser"
+}
+
+// Schema returns the schema for the data source.
+func (*RemoteBrowserDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get remote browser information by ID or name",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Remote browser identifier",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Remote browser name",
+				Optional:            true,
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "WebSocket URL of the remote browser",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source with the API client.
+func (d *RemoteBrowserDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	d.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read reads the current state of the data source.
+func (d *RemoteBrowserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RemoteBrowserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attempt to read by ID if provided.
+	if !data.ID.IsNull() && !data.ID.IsUnknown() {
+		rb, err := getRemoteBrowser(ctx, d.providerData, data.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to read remote browser", err.Error())
+			return
+		}
+
+		// Populate name and set response state.
+		data.Name = types.StringValue(rb.Name)
+		data.URL = types.StringValue(rb.URL)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Attempt to read by name if ID not provided.
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		remoteBrowsers, err := getRemoteBrowsers(ctx, d.providerData)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to read remote browsers", err.Error())
+			return
+		}
+
+		// Search for remote browser by name.
+		var found *remoteBrowser
+
+		for i := range remoteBrowsers {
+			if remoteBrowsers[i].Name == data.Name.ValueString() {
+				// Error if multiple remote browsers match name.
+				if found != nil {
+					resp.Diagnostics.AddError(
+						"Multiple remote browsers found",
+						fmt.Sprintf(
+							"Multiple remote browsers with name '%s' found. "+
+								"Please use 'id' to specify the remote browser uniquely.",
+							data.Name.ValueString(),
+						),
+					)
+					return
+				}
+
+				// Store matched remote browser.
+				found = &remoteBrowsers[i]
+			}
+		}
+
+		// Error if no remote browser found with given name.
+		if found == nil {
+			resp.Diagnostics.AddError(
+				"Remote browser not found",
+				fmt.Sprintf("No remote browser with name '%s' found.", data.Name.ValueString()),
+			)
+			return
+		}
+
+		// Populate ID and set response state.
+		data.ID = types.Int64Value(found.ID)
+		data.URL = types.StringValue(found.URL)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Error if neither ID nor name provided.
+	resp.Diagnostics.AddError(
+		"Missing query parameters",
+		"Either 'id' or 'name' must be specified.",
+	)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRemoteBrowserDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestRemoteBrowser")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemoteBrowserDataSourceConfig(name, "name"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_remote_browser.test",
+						tfjsonpath.New("url"),
+						knownvalue.StringExact("ws://browserless:3000/chromium/playwright"),
+					),
+				},
+			},
+			{
+				Config: testAccRemoteBrowserDataSourceConfig(name, "id"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_remote_browser.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(name),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccRemoteBrowserDataSourceConfig(name string, lookup string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_remote_browser" "test" {
+  name = %[1]q
+  url  = "ws://browserless:3000/chromium/playwright"
+}
+
+data "uptimekuma_remote_browser" "test" {
+  %[2]s = uptimekuma_remote_browser.test.%[2]s
+}
+`, name, lookup)
+}
@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
 )
 
 var _ datasource.DataSource = &StatusPageDataSource{}
@@ -19,14 +24,30 @@ func NewStatusPageDataSource() datasource.DataSource {
 
 // StatusPageDataSource manages status page data source operations.
 type StatusPageDataSource struct {
-	client *kuma.Client
+	providerData *providerData
 }
 
 // StatusPageDataSourceModel describes the data model for status page data source.
 type StatusPageDataSourceModel struct {
-	ID    types.Int64  `tfsdk:"id"`
-	Slug  types.String `tfsdk:"slug"`
-	Title types.String `tfsdk:"title"`
+	ID                    types.Int64  `tfsdk:"id"`
+	Slug                  types.String `tfsdk:"slug"`
+	Title                 types.String `tfsdk:"title"`
+	Description           types.String `tfsdk:"description"`
+	Icon                  types.String `tfsdk:"icon"`
+	Theme                 types.String `tfsdk:"theme"`
+	Published             types.Bool   `tfsdk:"published"`
+	ShowTags              types.Bool   `tfsdk:"show_tags"`
+	DomainNameList        types.List   `tfsdk:"domain_name_list"`
+	AnalyticsType         types.String `tfsdk:"analytics_type"`
+	AnalyticsID           types.String `tfsdk:"analytics_id"`
+	AnalyticsScriptURL    types.String `tfsdk:"analytics_script_url"`
+	CustomCSS             types.String `tfsdk:"custom_css"`
+	FooterText            types.String `tfsdk:"footer_text"`
+	ShowPoweredBy         types.Bool   `tfsdk:"show_powered_by"`
+	ShowCertificateExpiry types.Bool   `tfsdk:"show_certificate_expiry"`
+	AutoRefreshInterval   types.Int64  `tfsdk:"auto_refresh_interval"`
+	PublicGroupList       types.List   `tfsdk:"public_group_list"`
+	MaintenanceIDs        types.List   `tfsdk:"maintenance_ids"`
 }
 
 // Metadata returns the metadata for the data source.
@@ -39,6 +60,8 @@ func (*StatusPageDataSource) Metadata(
 }
 
 // Schema returns the schema for the data source.
+//
+//nolint:revive // function length is acceptable for Terraform provider schema definitions
 func (*StatusPageDataSource) Schema(
 	_ context.Context,
 	_ datasource.SchemaRequest,
@@ -61,6 +84,110 @@ func (*StatusPageDataSource) Schema(
 				MarkdownDescription: "Status page title",
 				Computed:            true,
 			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Status page description",
+				Computed:            true,
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: "Path or URL of the icon",
+				Computed:            true,
+			},
+			"theme": schema.StringAttribute{
+				MarkdownDescription: "Theme name for styling",
+				Computed:            true,
+			},
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "Whether page is publicly visible",
+				Computed:            true,
+			},
+			"show_tags": schema.BoolAttribute{
+				MarkdownDescription: "Show monitor tags on status page",
+				Computed:            true,
+			},
+			"domain_name_list": schema.ListAttribute{
+				MarkdownDescription: "Custom domain names",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"analytics_type": schema.StringAttribute{
+				MarkdownDescription: "Analytics provider type (e.g. google, matomo, plausible, umami)",
+				Computed:            true,
+			},
+			"analytics_id": schema.StringAttribute{
+				MarkdownDescription: "Analytics tracking ID",
+				Computed:            true,
+			},
+			"analytics_script_url": schema.StringAttribute{
+				MarkdownDescription: "Analytics script URL (used by matomo, plausible, umami)",
+				Computed:            true,
+			},
+			"custom_css": schema.StringAttribute{
+				MarkdownDescription: "Custom CSS styling",
+				Computed:            true,
+			},
+			"footer_text": schema.StringAttribute{
+				MarkdownDescription: "Footer content",
+				Computed:            true,
+			},
+			"show_powered_by": schema.BoolAttribute{
+				MarkdownDescription: "Display 'Powered by Uptime Kuma'",
+				Computed:            true,
+			},
+			"show_certificate_expiry": schema.BoolAttribute{
+				MarkdownDescription: "Show certificate expiry dates",
+				Computed:            true,
+			},
+			"auto_refresh_interval": schema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds, in which the status page refreshes itself. Null for " +
+					"Uptime Kuma 1.x",
+				Computed: true,
+			},
+			"public_group_list": schema.ListNestedAttribute{
+				MarkdownDescription: "Monitor groups of the status page",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Public group ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Group display name",
+							Computed:            true,
+						},
+						"weight": schema.Int64Attribute{
+							MarkdownDescription: "Display order/weight",
+							Computed:            true,
+						},
+						"monitor_list": schema.ListNestedAttribute{
+							MarkdownDescription: "Monitors in group",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.Int64Attribute{
+										MarkdownDescription: "Monitor ID",
+										Computed:            true,
+									},
+									"send_url": schema.BoolAttribute{
+										MarkdownDescription: "Whether the monitor URL is shown on the status page",
+										Computed:            true,
+									},
+									"url": schema.StringAttribute{
+										MarkdownDescription: "URL shown for the monitor on the status page, " +
+											"only set if `send_url` is true",
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"maintenance_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the maintenance windows shown on the status page",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -71,7 +198,7 @@ func (d *StatusPageDataSource) Configure(
 	req datasource.ConfigureRequest,
 	resp *datasource.ConfigureResponse,
 ) {
-	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	d.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
 }
 
 // Read reads the current state of the data source.
@@ -83,43 +210,174 @@ func (d *StatusPageDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	if !data.Slug.IsNull() && !data.Slug.IsUnknown() {
-		statusPage, err := d.client.GetStatusPage(ctx, data.Slug.ValueString())
+	// Attempt to resolve the slug by ID if no slug is provided.
+	if !isKnown(data.Slug) && isKnown(data.ID) {
+		statusPages, err := d.providerData.client.GetStatusPages(ctx)
 		if err != nil {
-			resp.Diagnostics.AddError("failed to read status page", err.Error())
+			resp.Diagnostics.AddError("failed to read status pages", err.Error())
 			return
 		}
 
-		data.ID = types.Int64Value(statusPage.ID)
-		data.Title = types.StringValue(statusPage.Title)
-		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		sp, ok := statusPages[data.ID.ValueInt64()]
+		if !ok {
+			resp.Diagnostics.AddError("failed to read status page", "Status page not found")
+			return
+		}
+
+		data.Slug = types.StringValue(sp.Slug)
+	}
+
+	if !isKnown(data.Slug) {
+		resp.Diagnostics.AddError(
+			// Error if neither ID nor name provided.
+			"Missing query parameters",
+			"Either 'id' or 'slug' must be specified.",
+		)
 		return
 	}
 
-	// Attempt to read by ID if provided.
-	if !data.ID.IsNull() && !data.ID.IsUnknown() {
-		statusPages, err := d.client.GetStatusPages(ctx)
-		if err != nil {
-			resp.Diagnostics.AddError("failed to read status pages", err.Error())
-			return
-		}
+	statusPage, err := d.providerData.client.GetStatusPage(ctx, data.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read status page", err.Error())
+		return
+	}
+
+	populateStatusPageDataSource(ctx, &data, statusPage, &resp.Diagnostics)
+
+	if d.providerData.clientConfig == nil {
+		resp.Diagnostics.AddError("failed to read status page", "provider connection settings are not available")
+		return
+	}
+
+	// The groups and the auto refresh interval are only available through the
+	// public status page API.
+	pageData, err := client.GetStatusPageData(ctx, d.providerData.clientConfig, data.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read status page groups", err.Error())
+		return
+	}
+
+	data.AutoRefreshInterval = types.Int64PointerValue(pageData.Config.AutoRefreshInterval)
+	data.PublicGroupList = publicGroupListFromStatusPageData(pageData.PublicGroupList, &resp.Diagnostics)
+	data.MaintenanceIDs = d.readMaintenanceIDs(ctx, statusPage.ID, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func populateStatusPageDataSource(
+	ctx context.Context,
+	data *StatusPageDataSourceModel,
+	sp *statuspage.StatusPage,
+	diags *diag.Diagnostics,
+) {
+	data.ID = types.Int64Value(sp.ID)
+	data.Title = types.StringValue(sp.Title)
+	data.Description = types.StringValue(sp.Description)
+	data.Icon = types.StringValue(sp.Icon)
+	data.Theme = types.StringValue(sp.Theme)
+	data.Published = types.BoolValue(sp.Published)
+	data.ShowTags = types.BoolValue(sp.ShowTags)
+	data.AnalyticsType = ptrToTypes(sp.AnalyticsType)
+	data.AnalyticsID = types.StringValue(sp.AnalyticsID)
+	data.AnalyticsScriptURL = types.StringValue(sp.AnalyticsScriptURL)
+	data.CustomCSS = types.StringValue(sp.CustomCSS)
+	data.FooterText = types.StringValue(sp.FooterText)
+	data.ShowPoweredBy = types.BoolValue(sp.ShowPoweredBy)
+	data.ShowCertificateExpiry = types.BoolValue(sp.ShowCertificateExpiry)
+
+	domainNames, valueDiags := types.ListValueFrom(ctx, types.StringType, append([]string{}, sp.DomainNameList...))
+	diags.Append(valueDiags...)
+	data.DomainNameList = domainNames
+}
+
+// publicGroupListFromStatusPageData converts the groups of the status page
+// read through the public status page API.
+func publicGroupListFromStatusPageData(publicGroups []client.PublicGroup, diags *diag.Diagnostics) types.List {
+	groupType := types.ObjectType{AttrTypes: statusPageDataSourceGroupAttrTypes()}
+
+	groups := make([]attr.Value, len(publicGroups))
 
-		for id, sp := range statusPages {
-			if id == data.ID.ValueInt64() {
-				data.Slug = types.StringValue(sp.Slug)
-				data.Title = types.StringValue(sp.Title)
-				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-				return
+	for i, group := range publicGroups {
+		monitors := make([]attr.Value, len(group.MonitorList))
+
+		for j, mon := range group.MonitorList {
+			url := types.StringNull()
+			if mon.SendURL {
+				url = types.StringValue(mon.URL)
 			}
+
+			objValue, valueDiags := types.ObjectValue(publicMonitorAttrType().AttrTypes, map[string]attr.Value{
+				"id":       types.Int64Value(mon.ID),
+				"send_url": types.BoolValue(mon.SendURL),
+				"url":      url,
+			})
+			diags.Append(valueDiags...)
+			monitors[j] = objValue
 		}
 
-		resp.Diagnostics.AddError("failed to read status page", "Status page not found")
-		return
+		monitorList, valueDiags := types.ListValue(publicMonitorAttrType(), monitors)
+		diags.Append(valueDiags...)
+
+		objValue, valueDiags := types.ObjectValue(statusPageDataSourceGroupAttrTypes(), map[string]attr.Value{
+			"id":           types.Int64Value(group.ID),
+			"name":         types.StringValue(group.Name),
+			"weight":       types.Int64Value(group.Weight),
+			"monitor_list": monitorList,
+		})
+		diags.Append(valueDiags...)
+		groups[i] = objValue
 	}
 
-	resp.Diagnostics.AddError(
-		// Error if neither ID nor name provided.
-		"Missing query parameters",
-		"Either 'id' or 'slug' must be specified.",
-	)
+	listValue, valueDiags := types.ListValue(groupType, groups)
+	diags.Append(valueDiags...)
+
+	return listValue
+}
+
+// readMaintenanceIDs returns the IDs of the maintenance windows linked to the
+// status page.
+func (d *StatusPageDataSource) readMaintenanceIDs(
+	ctx context.Context,
+	statusPageID int64,
+	diags *diag.Diagnostics,
+) types.List {
+	maintenances, err := d.providerData.client.GetMaintenances(ctx)
+	if err != nil {
+		diags.AddError("failed to read maintenances", err.Error())
+		return types.ListNull(types.Int64Type)
+	}
+
+	maintenanceIDs := []int64{}
+
+	for _, m := range maintenances {
+		statusPageIDs, err := d.providerData.client.GetMaintenanceStatusPage(ctx, m.ID)
+		if err != nil {
+			diags.AddError("failed to read maintenance status pages", err.Error())
+			return types.ListNull(types.Int64Type)
+		}
+
+		if slices.Contains(statusPageIDs, statusPageID) {
+			maintenanceIDs = append(maintenanceIDs, m.ID)
+		}
+	}
+
+	slices.Sort(maintenanceIDs)
+
+	listValue, valueDiags := types.ListValueFrom(ctx, types.Int64Type, maintenanceIDs)
+	diags.Append(valueDiags...)
+
+	return listValue
+}
+
+func statusPageDataSourceGroupAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.Int64Type,
+		"name":         types.StringType,
+		"weight":       types.Int64Type,
+		"monitor_list": types.ListType{ElemType: publicMonitorAttrType()},
+	}
 }
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
+)
+
+var _ datasource.DataSource = &StatusPageIncidentsDataSource{}
+
+// NewStatusPageIncidentsDataSource returns a new instance of the status page incidents data source.
+func NewStatusPageIncidentsDataSource() datasource.DataSource {
+	return &StatusPageIncidentsDataSource{}
+}
+
+// StatusPageIncidentsDataSource manages status page incidents data source operations.
+type StatusPageIncidentsDataSource struct {
+	providerData *providerData
+}
+
+// StatusPageIncidentsDataSourceModel describes the data model for status page incidents data source.
+type StatusPageIncidentsDataSourceModel struct {
+	StatusPageSlug types.String `tfsdk:"status_page_slug"`
+	Incidents      types.List   `tfsdk:"incidents"`
+}
+
+// Metadata returns the metadata for the data source.
+func (*StatusPageIncidentsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_incidents"
+}
+
+// Schema returns the schema for the data source.
+func (*StatusPageIncidentsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the incident history of a status page, newest first. Requires Uptime Kuma 2.x",
+		Attributes: map[string]schema.Attribute{
+			"status_page_slug": schema.StringAttribute{
+				MarkdownDescription: "Status page slug",
+				Required:            true,
+			},
+			"incidents": schema.ListNestedAttribute{
+				MarkdownDescription: "List of incidents",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Incident ID",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Incident title",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Incident description",
+							Computed:            true,
+						},
+						"style": schema.StringAttribute{
+							MarkdownDescription: "Incident style/severity",
+							Computed:            true,
+						},
+						"pin": schema.BoolAttribute{
+							MarkdownDescription: "Whether the incident is pinned to the status page",
+							Computed:            true,
+						},
+						"created_date": schema.StringAttribute{
+							MarkdownDescription: "Creation date of the incident (RFC3339)",
+							Computed:            true,
+						},
+						"last_updated_date": schema.StringAttribute{
+							MarkdownDescription: "Date of the last update of the incident (RFC3339)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source with the API client.
+func (d *StatusPageIncidentsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	d.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read reads the current state of the data source.
+func (d *StatusPageIncidentsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data StatusPageIncidentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.providerData.clientConfig == nil {
+		resp.Diagnostics.AddError(
+			"failed to read incidents",
+			"provider connection settings are not available",
+		)
+		return
+	}
+
+	incidents, err := client.GetStatusPageIncidentHistory(
+		ctx,
+		d.providerData.clientConfig,
+		data.StatusPageSlug.ValueString(),
+	)
+	if err != nil {
+		if errors.Is(err, client.ErrStatusPageNotFound) {
+			resp.Diagnostics.AddError(
+				"Status page not found",
+				"No status page with slug '"+data.StatusPageSlug.ValueString()+"' found.",
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to read incidents", err.Error())
+		return
+	}
+
+	incidentList := make([]attr.Value, len(incidents))
+	for i, incident := range incidents {
+		objValue, diags := types.ObjectValue(statusPageIncidentAttrTypes(), map[string]attr.Value{
+			"id":                types.Int64Value(incident.ID),
+			"title":             types.StringValue(incident.Title),
+			"content":           types.StringValue(incident.Content),
+			"style":             types.StringValue(incident.Style),
+			"pin":               types.BoolValue(incident.Pin),
+			"created_date":      incidentDate(incident.CreatedDate),
+			"last_updated_date": incidentDate(incident.LastUpdatedDate),
+		})
+		resp.Diagnostics.Append(diags...)
+		incidentList[i] = objValue
+	}
+
+	listValue, diags := types.ListValue(types.ObjectType{AttrTypes: statusPageIncidentAttrTypes()}, incidentList)
+	resp.Diagnostics.Append(diags...)
+	data.Incidents = listValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// statusPageIncidentAttrTypes returns the attribute types of an element of
+// `incidents`.
+func statusPageIncidentAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.Int64Type,
+		"title":             types.StringType,
+		"content":           types.StringType,
+		"style":             types.StringType,
+		"pin":               types.BoolType,
+		"created_date":      types.StringType,
+		"last_updated_date": types.StringType,
+	}
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccStatusPageIncidentsDataSource(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-incidents")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageIncidentsDataSourceConfig(slug),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page_incidents.test",
+						tfjsonpath.New("incidents"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page_incidents.test",
+						tfjsonpath.New("incidents").AtSliceIndex(0).AtMapKey("title"),
+						knownvalue.StringExact("Reported Incident"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page_incidents.test",
+						tfjsonpath.New("incidents").AtSliceIndex(0).AtMapKey("style"),
+						knownvalue.StringExact("danger"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page_incidents.test",
+						tfjsonpath.New("incidents").AtSliceIndex(0).AtMapKey("created_date"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccStatusPageIncidentsDataSourceConfig(slug string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_status_page" "test" {
+  slug      = %[1]q
+  title     = "Test Status Page"
+  published = true
+}
+
+resource "uptimekuma_status_page_incident" "test" {
+  status_page_slug = uptimekuma_status_page.test.slug
+  title            = "Reported Incident"
+  content          = "Incident for the history"
+  style            = "danger"
+  pin              = true
+}
+
+data "uptimekuma_status_page_incidents" "test" {
+  status_page_slug = uptimekuma_status_page.test.slug
+
+  depends_on = [uptimekuma_status_page_incident.test]
+}
+`, slug)
+}
@@ -52,3 +52,95 @@ data "uptimekuma_status_page" "test" {
 }
 `, slug, title)
 }
+
+func TestAccStatusPageDataSourceWithGroupsAndMaintenance(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-status")
+	monitorName := acctest.RandomWithPrefix("test-monitor")
+	maintenanceTitle := acctest.RandomWithPrefix("TestMaintenance")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageDataSourceConfigWithGroupsAndMaintenance(slug, monitorName, maintenanceTitle),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page.test",
+						tfjsonpath.New("footer_text"),
+						knownvalue.StringExact("Footer"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page.test",
+						tfjsonpath.New("domain_name_list"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact(slug + ".example.com")}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page.test",
+						tfjsonpath.New("public_group_list").AtSliceIndex(0).AtMapKey("name"),
+						knownvalue.StringExact("Services"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page.test",
+						tfjsonpath.New("public_group_list").AtSliceIndex(0).AtMapKey("monitor_list"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page.test",
+						tfjsonpath.New("maintenance_ids"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccStatusPageDataSourceConfigWithGroupsAndMaintenance(
+	slug string,
+	monitorName string,
+	maintenanceTitle string,
+) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_http" "test" {
+  name = %[2]q
+  url  = "https://example.com"
+}
+
+resource "uptimekuma_status_page" "test" {
+  slug             = %[1]q
+  title            = "Test Status Page"
+  footer_text      = "Footer"
+  domain_name_list = ["%[1]s.example.com"]
+
+  public_group_list = [
+    {
+      name = "Services"
+      monitor_list = [
+        {
+          id = uptimekuma_monitor_http.test.id
+        }
+      ]
+    }
+  ]
+}
+
+resource "uptimekuma_maintenance" "test" {
+  title    = %[3]q
+  strategy = "manual"
+  active   = false
+  timezone = "UTC"
+}
+
+resource "uptimekuma_maintenance_status_pages" "test" {
+  maintenance_id  = uptimekuma_maintenance.test.id
+  status_page_ids = [uptimekuma_status_page.test.id]
+}
+
+data "uptimekuma_status_page" "test" {
+  slug = uptimekuma_status_page.test.slug
+
+  depends_on = [uptimekuma_maintenance_status_pages.test]
+}
+`, slug, monitorName, maintenanceTitle)
+}
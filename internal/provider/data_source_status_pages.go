@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+var _ datasource.DataSource = &StatusPagesDataSource{}
+
+// NewStatusPagesDataSource returns a new instance of the status pages data source.
+func NewStatusPagesDataSource() datasource.DataSource {
+	return &StatusPagesDataSource{}
+}
+
+// StatusPagesDataSource manages status pages data source operations.
+type StatusPagesDataSource struct {
+	client *kuma.Client
+}
+
+// StatusPagesDataSourceModel describes the data model for status pages data source.
+type StatusPagesDataSourceModel struct {
+	StatusPages types.List `tfsdk:"status_pages"`
+}
+
+// Metadata returns the metadata for the data source.
+func (*StatusPagesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_status_pages"
+}
+
+// Schema returns the schema for the data source.
+func (*StatusPagesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all status pages",
+		Attributes: map[string]schema.Attribute{
+			"status_pages": schema.ListNestedAttribute{
+				MarkdownDescription: "List of status pages, ordered by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Status page identifier",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "Status page slug",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Status page title",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Status page description",
+							Computed:            true,
+						},
+						"published": schema.BoolAttribute{
+							MarkdownDescription: "Whether page is publicly visible",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source with the API client.
+func (d *StatusPagesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read reads the current state of the data source.
+func (d *StatusPagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StatusPagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusPages, err := d.client.GetStatusPages(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read status pages", err.Error())
+		return
+	}
+
+	statusPageAttrTypes := map[string]attr.Type{
+		"id":          types.Int64Type,
+		"slug":        types.StringType,
+		"title":       types.StringType,
+		"description": types.StringType,
+		"published":   types.BoolType,
+	}
+
+	ids := slices.Sorted(maps.Keys(statusPages))
+
+	statusPageList := make([]attr.Value, len(ids))
+	for i, id := range ids {
+		sp := statusPages[id]
+
+		objValue, diags := types.ObjectValue(statusPageAttrTypes, map[string]attr.Value{
+			"id":          types.Int64Value(id),
+			"slug":        types.StringValue(sp.Slug),
+			"title":       types.StringValue(sp.Title),
+			"description": types.StringValue(sp.Description),
+			"published":   types.BoolValue(sp.Published),
+		})
+		resp.Diagnostics.Append(diags...)
+		statusPageList[i] = objValue
+	}
+
+	listValue, diags := types.ListValue(types.ObjectType{AttrTypes: statusPageAttrTypes}, statusPageList)
+	resp.Diagnostics.Append(diags...)
+	data.StatusPages = listValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatusPagesDataSource(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-status")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPagesDataSourceConfig(slug),
+				Check: resource.TestCheckTypeSetElemNestedAttrs(
+					"data.uptimekuma_status_pages.test",
+					"status_pages.*",
+					map[string]string{
+						"slug":  slug,
+						"title": "Listed Status Page",
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccStatusPagesDataSourceConfig(slug string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_status_page" "test" {
+  slug  = %[1]q
+  title = "Listed Status Page"
+}
+
+data "uptimekuma_status_pages" "test" {
+  depends_on = [uptimekuma_status_page.test]
+}
+`, slug)
+}
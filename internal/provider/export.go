@@ -0,0 +1,450 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/dockerhost"
+	"github.com/breml/go-uptime-kuma-client/maintenance"
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+	"github.com/breml/go-uptime-kuma-client/proxy"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// ExportOptions configures the behavior of Export.
+type ExportOptions struct {
+	// IncludeSecrets writes sensitive values verbatim into the generated
+	// configuration instead of replacing them with references to sensitive
+	// input variables.
+	IncludeSecrets bool
+}
+
+// exportKind identifies the kind of Uptime Kuma object an exported resource
+// represents. It is used to resolve ID references between resources.
+type exportKind string
+
+const (
+	exportKindTag                    exportKind = "tag"
+	exportKindProxy                  exportKind = "proxy"
+	exportKindDockerHost             exportKind = "docker_host"
+	exportKindNotification           exportKind = "notification"
+	exportKindMonitor                exportKind = "monitor"
+	exportKindMaintenance            exportKind = "maintenance"
+	exportKindMaintenanceMonitors    exportKind = "maintenance_monitors"
+	exportKindMaintenanceStatusPages exportKind = "maintenance_status_pages"
+	exportKindStatusPage             exportKind = "status_page"
+	exportKindSettings               exportKind = "settings"
+)
+
+// exportReferences maps attribute paths (nested attribute names joined by
+// dots, list indexes omitted) to the kind of object the numeric ID stored in
+// the attribute refers to.
+func exportReferences() map[string]exportKind {
+	return map[string]exportKind{
+		"notification_ids":                  exportKindNotification,
+		"parent":                            exportKindMonitor,
+		"proxy_id":                          exportKindProxy,
+		"docker_host_id":                    exportKindDockerHost,
+		"tags.tag_id":                       exportKindTag,
+		"maintenance_id":                    exportKindMaintenance,
+		"monitor_ids":                       exportKindMonitor,
+		"status_page_ids":                   exportKindStatusPage,
+		"public_group_list.monitor_list.id": exportKindMonitor,
+	}
+}
+
+// exportOmittedAttributes lists the nested attribute paths (as in
+// exportReferences), which are not exported, because the object is already
+// identified by another attribute.
+func exportOmittedAttributes() []string {
+	return []string{
+		"tags.name",
+	}
+}
+
+// exportObject describes a single Uptime Kuma object to be exported as a
+// Terraform resource.
+type exportObject struct {
+	kind     exportKind
+	id       int64
+	importID string
+	typeName string
+	name     string
+	factory  func() resource.Resource
+}
+
+// address returns the Terraform resource address of the object.
+func (o *exportObject) address() string {
+	return o.typeName + "." + o.name
+}
+
+// exporter holds the state of a single export run.
+type exporter struct {
+	client    *kuma.Client
+	opts      ExportOptions
+	objects   []*exportObject
+	names     map[string]map[string]bool
+	refs      map[exportKind]map[int64]*exportObject
+	variables []exportVariable
+	comments  []string
+}
+
+// Export reads all objects supported by the provider from the Uptime Kuma
+// instance connected to kumaClient and writes Terraform configuration for
+// them to w. Besides the resource blocks, the output contains an import block
+// for every resource, so the existing objects can be brought under Terraform
+// management with a plain `terraform apply`. Numeric ID references between
+// objects are rewritten into resource references.
+//
+// Objects that fail to export are recorded as comments in the output and the
+// collected errors are returned after the configuration has been written.
+func Export(ctx context.Context, kumaClient *kuma.Client, w io.Writer, opts ExportOptions) error {
+	e := &exporter{
+		client: kumaClient,
+		opts:   opts,
+		names:  map[string]map[string]bool{},
+		refs: map[exportKind]map[int64]*exportObject{
+			exportKindTag:          {},
+			exportKindProxy:        {},
+			exportKindDockerHost:   {},
+			exportKindNotification: {},
+			exportKindMonitor:      {},
+			exportKindMaintenance:  {},
+			exportKindStatusPage:   {},
+		},
+	}
+
+	err := e.collect(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, exportErr := e.render(ctx)
+
+	_, err = w.Write(output)
+	if err != nil {
+		return fmt.Errorf("write export: %w", err)
+	}
+
+	return exportErr
+}
+
+// collect enumerates all exportable objects and assigns resource names.
+func (e *exporter) collect(ctx context.Context) error {
+	collectors := []func(context.Context) error{
+		e.collectTags,
+		e.collectProxies,
+		e.collectDockerHosts,
+		e.collectNotifications,
+		e.collectMonitors,
+		e.collectStatusPages,
+		e.collectMaintenances,
+		e.collectSettings,
+	}
+
+	for _, collector := range collectors {
+		err := collector(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) collectTags(ctx context.Context) error {
+	tags, err := e.client.GetTags(ctx)
+	if err != nil {
+		return fmt.Errorf("get tags: %w", err)
+	}
+
+	slices.SortFunc(tags, func(a, b tag.Tag) int { return cmp.Compare(a.ID, b.ID) })
+
+	for _, t := range tags {
+		e.add(ctx, exportKindTag, t.ID, t.Name, NewTagResource)
+	}
+
+	return nil
+}
+
+func (e *exporter) collectProxies(ctx context.Context) error {
+	proxies := e.client.GetProxyList(ctx)
+	slices.SortFunc(proxies, func(a, b proxy.Proxy) int { return cmp.Compare(a.ID, b.ID) })
+
+	for _, p := range proxies {
+		e.add(ctx, exportKindProxy, p.ID, p.Host, NewProxyResource)
+	}
+
+	return nil
+}
+
+func (e *exporter) collectDockerHosts(ctx context.Context) error {
+	hosts := e.client.GetDockerHostList(ctx)
+	slices.SortFunc(hosts, func(a, b dockerhost.DockerHost) int { return cmp.Compare(a.ID, b.ID) })
+
+	for _, h := range hosts {
+		e.add(ctx, exportKindDockerHost, h.ID, h.Name, NewDockerHostResource)
+	}
+
+	return nil
+}
+
+func (e *exporter) collectNotifications(ctx context.Context) error {
+	notifications := e.client.GetNotifications(ctx)
+	slices.SortFunc(notifications, func(a, b notification.Base) int { return cmp.Compare(a.ID, b.ID) })
+
+	typed := notificationResourcesByType()
+
+	for _, n := range notifications {
+		factory, ok := typed[n.Type()]
+		if !ok {
+			factory = NewNotificationResource
+		}
+
+		e.add(ctx, exportKindNotification, n.ID, n.Name, factory)
+	}
+
+	return nil
+}
+
+func (e *exporter) collectMonitors(ctx context.Context) error {
+	monitors, err := e.client.GetMonitors(ctx)
+	if err != nil {
+		return fmt.Errorf("get monitors: %w", err)
+	}
+
+	slices.SortFunc(monitors, func(a, b monitor.Base) int { return cmp.Compare(a.ID, b.ID) })
+
+	typed := monitorResourcesByType()
+
+	for _, m := range monitors {
+		factory, ok := typed[m.Type()]
+		if !ok {
+			e.comments = append(e.comments, fmt.Sprintf(
+				"monitor %d (%s) has type %q, which is not supported by the provider", m.ID, m.Name, m.Type(),
+			))
+
+			continue
+		}
+
+		e.add(ctx, exportKindMonitor, m.ID, m.Name, factory)
+	}
+
+	return nil
+}
+
+func (e *exporter) collectStatusPages(ctx context.Context) error {
+	statusPages, err := e.client.GetStatusPages(ctx)
+	if err != nil {
+		return fmt.Errorf("get status pages: %w", err)
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(statusPages)) {
+		sp := statusPages[id]
+		obj := e.add(ctx, exportKindStatusPage, sp.ID, sp.Slug, NewStatusPageResource)
+		obj.importID = sp.Slug
+	}
+
+	return nil
+}
+
+func (e *exporter) collectMaintenances(ctx context.Context) error {
+	maintenances, err := e.client.GetMaintenances(ctx)
+	if err != nil {
+		return fmt.Errorf("get maintenances: %w", err)
+	}
+
+	slices.SortFunc(maintenances, func(a, b maintenance.Maintenance) int { return cmp.Compare(a.ID, b.ID) })
+
+	for _, m := range maintenances {
+		e.add(ctx, exportKindMaintenance, m.ID, m.Title, NewMaintenanceResource)
+
+		monitorIDs, err := e.client.GetMonitorMaintenance(ctx, m.ID)
+		if err != nil {
+			return fmt.Errorf("get monitors of maintenance %d: %w", m.ID, err)
+		}
+
+		if len(monitorIDs) > 0 {
+			e.add(ctx, exportKindMaintenanceMonitors, m.ID, m.Title, NewMaintenanceMonitorsResource)
+		}
+
+		statusPageIDs, err := e.client.GetMaintenanceStatusPage(ctx, m.ID)
+		if err != nil {
+			return fmt.Errorf("get status pages of maintenance %d: %w", m.ID, err)
+		}
+
+		if len(statusPageIDs) > 0 {
+			e.add(ctx, exportKindMaintenanceStatusPages, m.ID, m.Title, NewMaintenanceStatusPagesResource)
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) collectSettings(ctx context.Context) error {
+	obj := e.add(ctx, exportKindSettings, 0, "settings", NewSettingsResource)
+	obj.importID = "settings"
+
+	return nil
+}
+
+// add registers an object for export. The import ID defaults to the numeric
+// ID of the object.
+func (e *exporter) add(
+	ctx context.Context,
+	kind exportKind,
+	id int64,
+	label string,
+	factory func() resource.Resource,
+) *exportObject {
+	var metadataResp resource.MetadataResponse
+	factory().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "uptimekuma"}, &metadataResp)
+
+	obj := &exportObject{
+		kind:     kind,
+		id:       id,
+		importID: strconv.FormatInt(id, 10),
+		typeName: metadataResp.TypeName,
+		factory:  factory,
+	}
+	obj.name = e.uniqueName(obj.typeName, exportLabel(label, kind, id), id)
+
+	e.objects = append(e.objects, obj)
+
+	refs, ok := e.refs[kind]
+	if ok {
+		refs[id] = obj
+	}
+
+	return obj
+}
+
+// uniqueName returns a resource name which is not yet used for the given
+// resource type. Conflicts are resolved by appending the object ID.
+func (e *exporter) uniqueName(typeName string, name string, id int64) string {
+	used, ok := e.names[typeName]
+	if !ok {
+		used = map[string]bool{}
+		e.names[typeName] = used
+	}
+
+	candidate := name
+	for i := 0; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, id)
+		if i > 0 {
+			candidate = fmt.Sprintf("%s_%d_%d", name, id, i)
+		}
+	}
+
+	used[candidate] = true
+
+	return candidate
+}
+
+// exportLabel converts a human readable name into a valid Terraform resource
+// name. Unsupported characters are replaced with underscores. Names that
+// would be empty or start with a digit are prefixed with the object kind.
+func exportLabel(label string, kind exportKind, id int64) string {
+	var b strings.Builder
+
+	pendingUnderscore := false
+
+	for _, r := range strings.ToLower(label) {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			pendingUnderscore = b.Len() > 0
+			continue
+		}
+
+		if pendingUnderscore {
+			b.WriteByte('_')
+			pendingUnderscore = false
+		}
+
+		b.WriteRune(r)
+	}
+
+	name := b.String()
+
+	switch {
+	case name == "":
+		return fmt.Sprintf("%s_%d", kind, id)
+	case name[0] >= '0' && name[0] <= '9':
+		return string(kind) + "_" + name
+	default:
+		return name
+	}
+}
+
+// readResourceState imports the object with the given import ID using the
+// resource's own ImportState and Read implementations, mirroring the steps
+// Terraform performs for `terraform import`. A null state is returned when
+// the object no longer exists.
+func readResourceState(
+	ctx context.Context,
+	res resource.Resource,
+	pd *providerData,
+	importID string,
+) (schema.Schema, tftypes.Value, error) {
+	var schemaResp resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	if configurable, ok := res.(resource.ResourceWithConfigure); ok {
+		var configureResp resource.ConfigureResponse
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: pd}, &configureResp)
+
+		if configureResp.Diagnostics.HasError() {
+			return schemaResp.Schema, tftypes.Value{}, diagnosticsError(configureResp.Diagnostics)
+		}
+	}
+
+	importer, ok := res.(resource.ResourceWithImportState)
+	if !ok {
+		return schemaResp.Schema, tftypes.Value{}, errors.New("resource does not support import")
+	}
+
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	importer.ImportState(ctx, resource.ImportStateRequest{ID: importID}, &importResp)
+
+	if importResp.Diagnostics.HasError() {
+		return schemaResp.Schema, tftypes.Value{}, diagnosticsError(importResp.Diagnostics)
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State}
+	res.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+
+	if readResp.Diagnostics.HasError() {
+		return schemaResp.Schema, tftypes.Value{}, diagnosticsError(readResp.Diagnostics)
+	}
+
+	return schemaResp.Schema, readResp.State.Raw, nil
+}
+
+// diagnosticsError converts the error diagnostics into a single error.
+func diagnosticsError(diags diag.Diagnostics) error {
+	errs := make([]error, 0, diags.ErrorsCount())
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+
+	return errors.Join(errs...)
+}
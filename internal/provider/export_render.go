@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exportVariable describes a generated input variable holding a sensitive
+// value which is not written to the exported configuration.
+type exportVariable struct {
+	name        string
+	typeName    string
+	description string
+}
+
+// render reads the state of all collected objects and renders the resulting
+// Terraform configuration.
+func (e *exporter) render(ctx context.Context) ([]byte, error) {
+	resources := hclwrite.NewEmptyFile()
+	body := resources.Body()
+
+	var errs []error
+
+	pd := &providerData{client: e.client}
+
+	for _, obj := range e.objects {
+		sch, state, err := readResourceState(ctx, obj.factory(), pd, obj.importID)
+		if err != nil {
+			err = fmt.Errorf("export %s (import ID %q): %w", obj.address(), obj.importID, err)
+			errs = append(errs, err)
+			e.comments = append(e.comments, err.Error())
+
+			continue
+		}
+
+		if state.IsNull() {
+			e.comments = append(e.comments, fmt.Sprintf("%s disappeared during export", obj.address()))
+			continue
+		}
+
+		block := body.AppendNewBlock("resource", []string{obj.typeName, obj.name})
+
+		err = e.renderAttributes(ctx, block.Body(), obj, sch, state)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("export %s: %w", obj.address(), err))
+		}
+
+		body.AppendNewline()
+
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: obj.typeName},
+			hcl.TraverseAttr{Name: obj.name},
+		})
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(obj.importID))
+
+		body.AppendNewline()
+	}
+
+	header := hclwrite.NewEmptyFile()
+	header.Body().AppendUnstructuredTokens(commentTokens("Generated by terraform-provider-uptimekuma export."))
+
+	for _, comment := range e.comments {
+		header.Body().AppendUnstructuredTokens(commentTokens(comment))
+	}
+
+	header.Body().AppendNewline()
+
+	for _, v := range e.variables {
+		block := header.Body().AppendNewBlock("variable", []string{v.name})
+		block.Body().SetAttributeValue("description", cty.StringVal(v.description))
+		block.Body().SetAttributeRaw("type", hclwrite.TokensForIdentifier(v.typeName))
+		block.Body().SetAttributeValue("sensitive", cty.True)
+		header.Body().AppendNewline()
+	}
+
+	output := append(header.Bytes(), resources.Bytes()...)
+
+	return hclwrite.Format(output), errors.Join(errs...)
+}
+
+// renderAttributes writes the configurable attributes of the state to body.
+// Attributes are written in alphabetical order, except for the attributes
+// naming the object, which are written first.
+func (e *exporter) renderAttributes(
+	ctx context.Context,
+	body *hclwrite.Body,
+	obj *exportObject,
+	sch schema.Schema,
+	state tftypes.Value,
+) error {
+	var values map[string]tftypes.Value
+
+	err := state.As(&values)
+	if err != nil {
+		return fmt.Errorf("decode state: %w", err)
+	}
+
+	names := slices.SortedFunc(maps.Keys(sch.Attributes), compareExportAttributeNames)
+
+	for _, name := range names {
+		attribute := sch.Attributes[name]
+		value := values[name]
+
+		if !exportAttribute(ctx, attribute, value) {
+			continue
+		}
+
+		if attribute.IsSensitive() && !e.opts.IncludeSecrets {
+			body.SetAttributeTraversal(name, e.addVariable(obj, name, value.Type()))
+			continue
+		}
+
+		tokens, err := e.valueTokens(value, name)
+		if err != nil {
+			return fmt.Errorf("attribute %s: %w", name, err)
+		}
+
+		body.SetAttributeRaw(name, tokens)
+	}
+
+	return nil
+}
+
+// compareExportAttributeNames orders attribute names alphabetically, with the
+// attributes naming the object first.
+func compareExportAttributeNames(a string, b string) int {
+	leading := []string{"name", "title", "slug"}
+
+	ai := slices.Index(leading, a)
+	bi := slices.Index(leading, b)
+
+	switch {
+	case ai >= 0 && bi >= 0:
+		return ai - bi
+	case ai >= 0:
+		return -1
+	case bi >= 0:
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// exportAttribute reports whether the attribute value belongs into the
+// exported configuration. Null values, computed-only attributes and values
+// equal to the schema default are omitted.
+func exportAttribute(ctx context.Context, attribute schema.Attribute, value tftypes.Value) bool {
+	if value.IsNull() || !value.IsKnown() {
+		return false
+	}
+
+	if attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired() {
+		return false
+	}
+
+	defaultValue, ok := attributeDefault(ctx, attribute)
+	if ok && defaultValue.Equal(value) {
+		return false
+	}
+
+	return true
+}
+
+// attributeDefault returns the static default value of the attribute, if any.
+func attributeDefault(ctx context.Context, attribute schema.Attribute) (tftypes.Value, bool) {
+	var value attr.Value
+
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false
+		}
+
+		var resp defaults.StringResponse
+		a.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+		value = resp.PlanValue
+	case schema.BoolAttribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false
+		}
+
+		var resp defaults.BoolResponse
+		a.Default.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
+		value = resp.PlanValue
+	case schema.Int64Attribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false
+		}
+
+		var resp defaults.Int64Response
+		a.Default.DefaultInt64(ctx, defaults.Int64Request{}, &resp)
+		value = resp.PlanValue
+	case schema.ListAttribute:
+		if a.Default == nil {
+			return tftypes.Value{}, false
+		}
+
+		var resp defaults.ListResponse
+		a.Default.DefaultList(ctx, defaults.ListRequest{}, &resp)
+		value = resp.PlanValue
+	default:
+		return tftypes.Value{}, false
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		return tftypes.Value{}, false
+	}
+
+	return tfValue, true
+}
+
+// valueTokens renders a value as HCL expression. Numeric IDs of other
+// exported objects are replaced by references to the respective resource.
+func (e *exporter) valueTokens(value tftypes.Value, attrPath string) (hclwrite.Tokens, error) {
+	typ := value.Type()
+
+	switch {
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		return e.collectionTokens(value, attrPath)
+	case typ.Is(tftypes.Object{}), typ.Is(tftypes.Map{}):
+		return e.objectTokens(value, attrPath)
+	case typ.Is(tftypes.Number):
+		var number big.Float
+
+		err := value.As(&number)
+		if err != nil {
+			return nil, fmt.Errorf("decode number: %w", err)
+		}
+
+		traversal := e.reference(attrPath, &number)
+		if traversal != nil {
+			return hclwrite.TokensForTraversal(traversal), nil
+		}
+
+		return hclwrite.TokensForValue(cty.NumberVal(&number)), nil
+	case typ.Is(tftypes.String):
+		var str string
+
+		err := value.As(&str)
+		if err != nil {
+			return nil, fmt.Errorf("decode string: %w", err)
+		}
+
+		return hclwrite.TokensForValue(cty.StringVal(str)), nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		err := value.As(&b)
+		if err != nil {
+			return nil, fmt.Errorf("decode bool: %w", err)
+		}
+
+		return hclwrite.TokensForValue(cty.BoolVal(b)), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", typ)
+	}
+}
+
+func (e *exporter) collectionTokens(value tftypes.Value, attrPath string) (hclwrite.Tokens, error) {
+	var elems []tftypes.Value
+
+	err := value.As(&elems)
+	if err != nil {
+		return nil, fmt.Errorf("decode collection: %w", err)
+	}
+
+	tokens := make([]hclwrite.Tokens, 0, len(elems))
+
+	for _, elem := range elems {
+		if elem.IsNull() {
+			continue
+		}
+
+		elemTokens, err := e.valueTokens(elem, attrPath)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, elemTokens)
+	}
+
+	return hclwrite.TokensForTuple(tokens), nil
+}
+
+func (e *exporter) objectTokens(value tftypes.Value, attrPath string) (hclwrite.Tokens, error) {
+	var attrs map[string]tftypes.Value
+
+	err := value.As(&attrs)
+	if err != nil {
+		return nil, fmt.Errorf("decode object: %w", err)
+	}
+
+	isMap := value.Type().Is(tftypes.Map{})
+	tokens := make([]hclwrite.ObjectAttrTokens, 0, len(attrs))
+
+	for _, key := range slices.Sorted(maps.Keys(attrs)) {
+		if attrs[key].IsNull() {
+			continue
+		}
+
+		nestedPath := attrPath + "." + key
+		if isMap {
+			nestedPath = attrPath
+		} else if slices.Contains(exportOmittedAttributes(), nestedPath) {
+			continue
+		}
+
+		valueTokens, err := e.valueTokens(attrs[key], nestedPath)
+		if err != nil {
+			return nil, err
+		}
+
+		nameTokens := hclwrite.TokensForIdentifier(key)
+		if isMap {
+			nameTokens = hclwrite.TokensForValue(cty.StringVal(key))
+		}
+
+		tokens = append(tokens, hclwrite.ObjectAttrTokens{Name: nameTokens, Value: valueTokens})
+	}
+
+	return hclwrite.TokensForObject(tokens), nil
+}
+
+// reference returns a traversal to the id attribute of the exported resource
+// the numeric value at attrPath refers to, or nil if the attribute is not a
+// reference or the referenced object is not part of the export.
+func (e *exporter) reference(attrPath string, number *big.Float) hcl.Traversal {
+	kind, ok := exportReferences()[attrPath]
+	if !ok {
+		return nil
+	}
+
+	id, accuracy := number.Int64()
+	if accuracy != big.Exact {
+		return nil
+	}
+
+	obj, ok := e.refs[kind][id]
+	if !ok {
+		return nil
+	}
+
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: obj.typeName},
+		hcl.TraverseAttr{Name: obj.name},
+		hcl.TraverseAttr{Name: "id"},
+	}
+}
+
+// addVariable registers a sensitive input variable for the attribute of the
+// object and returns the traversal referencing it.
+func (e *exporter) addVariable(obj *exportObject, attrName string, typ tftypes.Type) hcl.Traversal {
+	name := e.uniqueName("variable", obj.name+"_"+attrName, obj.id)
+
+	typeName := "any"
+
+	switch {
+	case typ.Is(tftypes.String):
+		typeName = "string"
+	case typ.Is(tftypes.Number):
+		typeName = "number"
+	case typ.Is(tftypes.Bool):
+		typeName = "bool"
+	}
+
+	e.variables = append(e.variables, exportVariable{
+		name:        name,
+		typeName:    typeName,
+		description: fmt.Sprintf("Value for %s of %s.", attrName, obj.address()),
+	})
+
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	}
+}
+
+// commentTokens returns the tokens for a single line comment.
+func commentTokens(comment string) hclwrite.Tokens {
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte("# " + strings.ReplaceAll(comment, "\n", " ") + "\n")},
+	}
+}
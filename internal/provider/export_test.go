@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestExportLabel(t *testing.T) {
+	tests := []struct {
+		label    string
+		expected string
+	}{
+		{label: "Example API", expected: "example_api"},
+		{label: "  --api.example.com:443--  ", expected: "api_example_com_443"},
+		{label: "Ünïcode Name", expected: "n_code_name"},
+		{label: "1st Monitor", expected: "monitor_1st_monitor"},
+		{label: "!!!", expected: "monitor_42"},
+		{label: "", expected: "monitor_42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			got := exportLabel(tt.label, exportKindMonitor, 42)
+			if got != tt.expected {
+				t.Errorf("exportLabel(%q) = %q, want %q", tt.label, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExporterUniqueName(t *testing.T) {
+	e := &exporter{names: map[string]map[string]bool{}}
+
+	got := []string{
+		e.uniqueName("uptimekuma_tag", "prod", 1),
+		e.uniqueName("uptimekuma_tag", "prod", 2),
+		e.uniqueName("uptimekuma_monitor_http", "prod", 3),
+		e.uniqueName("uptimekuma_tag", "prod_2", 4),
+		e.uniqueName("uptimekuma_tag", "prod", 2),
+	}
+	expected := []string{"prod", "prod_2", "prod", "prod_2_4", "prod_2_1"}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("uniqueName call %d = %q, want %q", i, got[i], expected[i])
+		}
+	}
+}
+
+type exportTestModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Active          types.Bool   `tfsdk:"active"`
+	Interval        types.Int64  `tfsdk:"interval"`
+	NotificationIDs types.List   `tfsdk:"notification_ids"`
+	Parent          types.Int64  `tfsdk:"parent"`
+	Password        types.String `tfsdk:"password"`
+	Description     types.String `tfsdk:"description"`
+	Tags            types.Set    `tfsdk:"tags"`
+}
+
+func TestExporterRenderAttributes(t *testing.T) {
+	ctx := context.Background()
+
+	tagType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"tag_id": types.Int64Type,
+		"name":   types.StringType,
+		"value":  types.StringType,
+	}}
+
+	sch := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":       schema.Int64Attribute{Computed: true},
+			"name":     schema.StringAttribute{Required: true},
+			"active":   schema.BoolAttribute{Optional: true, Computed: true, Default: booldefault.StaticBool(true)},
+			"interval": schema.Int64Attribute{Optional: true, Computed: true, Default: int64default.StaticInt64(60)},
+			"notification_ids": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"parent":      schema.Int64Attribute{Optional: true},
+			"password":    schema.StringAttribute{Optional: true, Sensitive: true},
+			"description": schema.StringAttribute{Optional: true},
+			"tags": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_id": schema.Int64Attribute{Optional: true, Computed: true},
+						"name":   schema.StringAttribute{Optional: true, Computed: true},
+						"value":  schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	tags := types.SetValueMust(tagType, []attr.Value{
+		types.ObjectValueMust(tagType.AttrTypes, map[string]attr.Value{
+			"tag_id": types.Int64Value(3),
+			"name":   types.StringValue("env"),
+			"value":  types.StringValue("prod"),
+		}),
+	})
+
+	state := tfsdk.State{
+		Schema: sch,
+		Raw:    tftypes.NewValue(sch.Type().TerraformType(ctx), nil),
+	}
+
+	diags := state.Set(ctx, &exportTestModel{
+		ID:       types.Int64Value(10),
+		Name:     types.StringValue("Example API"),
+		Active:   types.BoolValue(true),
+		Interval: types.Int64Value(30),
+		NotificationIDs: types.ListValueMust(types.Int64Type, []attr.Value{
+			types.Int64Value(1),
+			types.Int64Value(99),
+		}),
+		Parent:      types.Int64Value(2),
+		Password:    types.StringValue("secret"),
+		Description: types.StringNull(),
+		Tags:        tags,
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to set state: %v", diags)
+	}
+
+	e := &exporter{
+		names: map[string]map[string]bool{},
+		refs: map[exportKind]map[int64]*exportObject{
+			exportKindNotification: {1: {typeName: "uptimekuma_notification_slack", name: "ops"}},
+			exportKindMonitor:      {2: {typeName: "uptimekuma_monitor_group", name: "backend"}},
+			exportKindTag:          {3: {typeName: "uptimekuma_tag", name: "env"}},
+		},
+	}
+	obj := &exportObject{id: 10, typeName: "uptimekuma_monitor_http", name: "example_api"}
+
+	file := hclwrite.NewEmptyFile()
+
+	err := e.renderAttributes(ctx, file.Body(), obj, sch, state.Raw)
+	if err != nil {
+		t.Fatalf("renderAttributes() error: %v", err)
+	}
+
+	expected := `name             = "Example API"
+interval         = 30
+notification_ids = [uptimekuma_notification_slack.ops.id, 99]
+parent           = uptimekuma_monitor_group.backend.id
+password         = var.example_api_password
+tags = [{
+  tag_id = uptimekuma_tag.env.id
+  value  = "prod"
+}]
+`
+
+	got := string(hclwrite.Format(file.Bytes()))
+	if got != expected {
+		t.Errorf("renderAttributes() =\n%s\nwant:\n%s", got, expected)
+	}
+
+	if len(e.variables) != 1 || e.variables[0].name != "example_api_password" || e.variables[0].typeName != "string" {
+		t.Errorf("unexpected variables: %+v", e.variables)
+	}
+}
+
+func TestAccExport(t *testing.T) {
+	tagName := acctest.RandomWithPrefix("TestExportTag")
+	notificationName := acctest.RandomWithPrefix("TestExportNotification")
+	monitorName := acctest.RandomWithPrefix("TestExportMonitor")
+	kumaClient := testAccOutOfBandClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportConfig(tagName, notificationName, monitorName),
+				Check: func(s *terraform.State) error {
+					var buf bytes.Buffer
+
+					err := Export(t.Context(), kumaClient, &buf, ExportOptions{})
+					if err != nil {
+						return err
+					}
+
+					monitorID := s.RootModule().Resources["uptimekuma_monitor_http.test"].Primary.ID
+
+					return testAccCheckExportOutput(buf.String(),
+						`resource "uptimekuma_monitor_http" "`+exportLabel(monitorName, exportKindMonitor, 0)+`"`,
+						`notification_ids += \[uptimekuma_notification_webhook\.\w+\.id\]`,
+						`tag_id = uptimekuma_tag\.\w+\.id`,
+						`id = "`+monitorID+`"`,
+						`to = uptimekuma_settings\.settings`,
+					)
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckExportOutput(output string, patterns ...string) error {
+	for _, pattern := range patterns {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			return fmt.Errorf("export output does not match %q:\n%s", pattern, output)
+		}
+	}
+
+	return nil
+}
+
+func testAccExportConfig(tagName string, notificationName string, monitorName string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_tag" "test" {
+  name  = %[1]q
+  color = "#3498db"
+}
+
+resource "uptimekuma_notification_webhook" "test" {
+  name                 = %[2]q
+  webhook_url          = "https://example.com/hook"
+  webhook_content_type = "json"
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  name             = %[3]q
+  url              = "https://example.com"
+  notification_ids = [uptimekuma_notification_webhook.test.id]
+
+  tags = [{
+    tag_id = uptimekuma_tag.test.id
+    value  = "export"
+  }]
+}
+`, tagName, notificationName, monitorName)
+}
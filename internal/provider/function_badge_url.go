@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &BadgeURLFunction{}
+
+// badgeDurationRegexp matches the durations accepted by the uptime, ping and
+// avg-response badges, e.g. `24`, `24h` or `30d`. Plain numbers are hours.
+var badgeDurationRegexp = regexp.MustCompile(`^[1-9][0-9]*[hd]?$`)
+
+// badgeKinds returns the badge kinds served by Uptime Kuma below `/api/badge/{id}/`.
+func badgeKinds() []string {
+	return []string{"status", "uptime", "ping", "avg-response", "cert-exp", "response"}
+}
+
+// badgeDurationKinds returns the badge kinds accepting a duration path segment.
+func badgeDurationKinds() []string {
+	return []string{"uptime", "ping", "avg-response"}
+}
+
+// badgeOptions returns the query parameters understood by the badge endpoints.
+func badgeOptions() []string {
+	return []string{
+		"color",
+		"downColor",
+		"downDays",
+		"downLabel",
+		"label",
+		"labelColor",
+		"labelPrefix",
+		"labelSuffix",
+		"maintenanceColor",
+		"maintenanceLabel",
+		"pendingColor",
+		"pendingLabel",
+		"prefix",
+		"style",
+		"suffix",
+		"upColor",
+		"upLabel",
+		"value",
+		"warnColor",
+		"warnDays",
+	}
+}
+
+// NewBadgeURLFunction returns a new instance of the badge URL function.
+func NewBadgeURLFunction() function.Function {
+	return &BadgeURLFunction{}
+}
+
+// BadgeURLFunction defines the function implementation.
+type BadgeURLFunction struct{}
+
+// Metadata returns the metadata for the function.
+func (*BadgeURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "badge_url"
+}
+
+// Definition returns the definition for the function.
+func (*BadgeURLFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Build the URL of a monitor badge",
+		MarkdownDescription: "Returns the URL of an SVG badge for a monitor in the form " +
+			"`{base}/api/badge/{monitor_id}/{kind}[/{duration}]?{options}`. " +
+			"Badges are only served for monitors shown on a published status page.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "base",
+				MarkdownDescription: "Base URL of the Uptime Kuma instance, e.g. `https://uptime.example.com`",
+			},
+			function.Int64Parameter{
+				Name:                "monitor_id",
+				MarkdownDescription: "ID of the monitor",
+			},
+			function.StringParameter{
+				Name: "kind",
+				MarkdownDescription: "Kind of the badge. Valid values: `" +
+					strings.Join(badgeKinds(), "`, `") + "`.",
+			},
+			function.MapParameter{
+				Name:        "options",
+				ElementType: types.StringType,
+				MarkdownDescription: "Badge options, passed as query parameters, e.g. `{ style = \"flat-square\" }`. " +
+					"The `duration` option (e.g. `24h` or `30d`) is supported by the `" +
+					strings.Join(badgeDurationKinds(), "`, `") + "` badges. Valid options: `duration`, `" +
+					strings.Join(badgeOptions(), "`, `") + "`. May be `null`.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the badge URL.
+func (*BadgeURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		base, kind string
+		monitorID  int64
+		options    types.Map
+	)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &base, &monitorID, &kind, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts := map[string]string{}
+	for key, value := range options.Elements() {
+		str, ok := value.(types.String)
+		if !ok || str.IsNull() {
+			resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("option %q must not be null", key))
+			return
+		}
+
+		opts[key] = str.ValueString()
+	}
+
+	result, funcErr := badgeURL(base, monitorID, kind, opts)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func badgeURL(base string, monitorID int64, kind string, options map[string]string) (string, *function.FuncError) {
+	baseURL, err := parseBaseURL(base)
+	if err != nil {
+		return "", function.NewArgumentFuncError(0, err.Error())
+	}
+
+	if monitorID <= 0 {
+		return "", function.NewArgumentFuncError(1, "monitor_id must be a positive number")
+	}
+
+	if !slices.Contains(badgeKinds(), kind) {
+		return "", function.NewArgumentFuncError(
+			2,
+			fmt.Sprintf("kind %q must be one of: %s", kind, strings.Join(badgeKinds(), ", ")),
+		)
+	}
+
+	segments := []string{"api", "badge", strconv.FormatInt(monitorID, 10), kind}
+	query := url.Values{}
+
+	for key, value := range options {
+		switch {
+		case key == "duration":
+			if !slices.Contains(badgeDurationKinds(), kind) {
+				return "", function.NewArgumentFuncError(
+					3,
+					fmt.Sprintf("option duration is not supported by the %s badge", kind),
+				)
+			}
+
+			if !badgeDurationRegexp.MatchString(value) {
+				return "", function.NewArgumentFuncError(
+					3,
+					fmt.Sprintf("duration %q must be a number of hours or days, e.g. 24h or 30d", value),
+				)
+			}
+
+			segments = append(segments, value)
+
+		case slices.Contains(badgeOptions(), key):
+			query.Set(key, value)
+
+		default:
+			return "", function.NewArgumentFuncError(3, fmt.Sprintf("unsupported badge option %q", key))
+		}
+	}
+
+	result := joinURL(baseURL, segments...)
+	if len(query) > 0 {
+		result += "?" + query.Encode()
+	}
+
+	return result, nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestBadgeURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		monitorID int64
+		kind      string
+		options   map[string]string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:      "status",
+			monitorID: 1,
+			kind:      "status",
+			expected:  "https://uptime.example.com/api/badge/1/status",
+		},
+		{
+			name:      "uptime with duration and options",
+			monitorID: 7,
+			kind:      "uptime",
+			options:   map[string]string{"duration": "30d", "style": "flat-square", "labelSuffix": " (30 days)"},
+			expected:  "https://uptime.example.com/api/badge/7/uptime/30d?labelSuffix=+%2830+days%29&style=flat-square",
+		},
+		{name: "unknown kind", monitorID: 1, kind: "latency", expectErr: true},
+		{name: "invalid monitor id", monitorID: 0, kind: "status", expectErr: true},
+		{
+			name:      "duration not supported",
+			monitorID: 1,
+			kind:      "cert-exp",
+			options:   map[string]string{"duration": "24h"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid duration",
+			monitorID: 1,
+			kind:      "ping",
+			options:   map[string]string{"duration": "1w"},
+			expectErr: true,
+		},
+		{
+			name:      "unknown option",
+			monitorID: 1,
+			kind:      "status",
+			options:   map[string]string{"theme": "dark"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, funcErr := badgeURL("https://uptime.example.com", tt.monitorID, tt.kind, tt.options)
+			if tt.expectErr {
+				if funcErr == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+
+				return
+			}
+
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %v", funcErr)
+			}
+
+			if got != tt.expected {
+				t.Errorf("badgeURL() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAccBadgeURLFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::uptimekuma::badge_url("https://uptime.example.com", 3, "avg-response", { duration = "24h" })
+}
+`,
+				Check: resource.TestCheckOutput("test", "https://uptime.example.com/api/badge/3/avg-response/24h"),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::uptimekuma::badge_url("https://uptime.example.com", 3, "status", null)
+}
+`,
+				Check: resource.TestCheckOutput("test", "https://uptime.example.com/api/badge/3/status"),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::uptimekuma::badge_url("https://uptime.example.com", 3, "latency", null)
+}
+`,
+				ExpectError: regexp.MustCompile("kind \"latency\" must be one of"),
+			},
+		},
+	})
+}
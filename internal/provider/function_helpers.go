@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// statusPageSlugRegexp matches the slugs accepted by Uptime Kuma for status pages.
+var statusPageSlugRegexp = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// parseBaseURL validates the base URL of an Uptime Kuma instance and returns
+// it without a trailing slash. Base URLs with a path are supported for
+// instances served behind a reverse proxy on a sub path.
+func parseBaseURL(base string) (*url.URL, error) {
+	if base == "" {
+		return nil, errors.New("base URL must not be empty")
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL %q: %w", base, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("base URL %q must use the http or https scheme", base)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("base URL %q must contain a host", base)
+	}
+
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return nil, fmt.Errorf("base URL %q must not contain credentials, a query or a fragment", base)
+	}
+
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+
+	return u, nil
+}
+
+// joinURL appends the already escaped path segments to the base URL.
+func joinURL(base *url.URL, segments ...string) string {
+	return base.String() + "/" + strings.Join(segments, "/")
+}
+
+// validateStatusPageSlug checks the slug against the format accepted by Uptime Kuma.
+func validateStatusPageSlug(slug string) error {
+	if !statusPageSlugRegexp.MatchString(slug) {
+		return fmt.Errorf(
+			"slug %q must only contain lowercase letters, numbers and single dashes between them",
+			slug,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// maxMaintenanceWindows limits the number of windows returned by the
+// maintenance windows function.
+const maxMaintenanceWindows = 1000
+
+// The file is not named after the function, as the suffix `_windows.go`
+// restricts the build of a file to Windows.
+var _ function.Function = &MaintenanceWindowsFunction{}
+
+// NewMaintenanceWindowsFunction returns a new instance of the maintenance windows function.
+func NewMaintenanceWindowsFunction() function.Function {
+	return &MaintenanceWindowsFunction{}
+}
+
+// MaintenanceWindowsFunction defines the function implementation.
+type MaintenanceWindowsFunction struct{}
+
+// Metadata returns the metadata for the function.
+func (*MaintenanceWindowsFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "maintenance_windows"
+}
+
+// Definition returns the definition for the function.
+func (*MaintenanceWindowsFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the upcoming windows of a maintenance",
+		MarkdownDescription: "Returns the upcoming windows of a maintenance as a list of objects with " +
+			"`start_date` and `end_date` (RFC3339, UTC), computed the same way as by Uptime Kuma. A window in " +
+			"progress at `from` is included. Windows of recurring strategies keep their local time across " +
+			"daylight saving time changes, times skipped by a change are moved forward by the length of the " +
+			"change. The windows of the `recurring-interval` strategy repeat every `interval_day` days after " +
+			"the first window.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "strategy_config",
+				MarkdownDescription: "Object with the attributes of `uptimekuma_maintenance` defining the " +
+					"schedule: `strategy`, `start_date`, `end_date`, `interval_day`, `weekdays`, " +
+					"`days_of_month`, `cron`, `duration_minutes`, `start_time` and `end_time`. Other " +
+					"attributes are ignored, so the resource itself can be passed.",
+			},
+			function.StringParameter{
+				Name: "timezone",
+				MarkdownDescription: "`UTC` or an IANA timezone, e.g. `Europe/Zurich`. `SAME_AS_SERVER` is " +
+					"not supported, as the timezone of the server is not known locally.",
+			},
+			function.StringParameter{
+				Name:                "from",
+				MarkdownDescription: "Time to compute the windows from (RFC3339), e.g. `timestamp()`",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Maximum number of windows, between 1 and %d", maxMaintenanceWindows),
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: maintenanceWindowAttrTypes()},
+		},
+	}
+}
+
+// Run computes the maintenance windows.
+func (*MaintenanceWindowsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		strategyConfig types.Dynamic
+		timezone, from string
+		count          int64
+	)
+
+	resp.Error = function.ConcatFuncErrors(
+		resp.Error,
+		req.Arguments.Get(ctx, &strategyConfig, &timezone, &from, &count),
+	)
+	if resp.Error != nil {
+		return
+	}
+
+	cfg, funcErr := maintenanceScheduleConfigFromDynamic(ctx, strategyConfig)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, funcErr := maintenanceWindowsList(ctx, cfg, timezone, from, count)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// maintenanceWindowAttrTypes returns the attribute types of a maintenance
+// window, the same as of the elements of `timeslot_list`.
+func maintenanceWindowAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start_date": types.StringType,
+		"end_date":   types.StringType,
+	}
+}
+
+// maintenanceWindowsToList converts the windows in the format of
+// `timeslot_list`.
+func maintenanceWindowsToList(windows []maintenanceWindow) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make([]attr.Value, len(windows))
+	for i, window := range windows {
+		objValue, d := types.ObjectValue(maintenanceWindowAttrTypes(), map[string]attr.Value{
+			"start_date": types.StringValue(window.Start.UTC().Format(time.RFC3339)),
+			"end_date":   types.StringValue(window.End.UTC().Format(time.RFC3339)),
+		})
+		diags.Append(d...)
+		elements[i] = objValue
+	}
+
+	listValue, d := types.ListValue(types.ObjectType{AttrTypes: maintenanceWindowAttrTypes()}, elements)
+	diags.Append(d...)
+
+	return listValue, diags
+}
+
+func maintenanceWindowsList(
+	ctx context.Context,
+	cfg maintenanceScheduleConfig,
+	timezone string,
+	from string,
+	count int64,
+) (types.List, *function.FuncError) {
+	elementType := types.ObjectType{AttrTypes: maintenanceWindowAttrTypes()}
+
+	loc, err := maintenanceLocation(timezone)
+	if err != nil {
+		return types.ListNull(elementType), function.NewArgumentFuncError(1, err.Error())
+	}
+
+	fromTime, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		return types.ListNull(elementType), function.NewArgumentFuncError(
+			2,
+			fmt.Sprintf("from %q must be a RFC3339 timestamp", from),
+		)
+	}
+
+	if count < 1 || count > maxMaintenanceWindows {
+		return types.ListNull(elementType), function.NewArgumentFuncError(
+			3,
+			fmt.Sprintf("count must be between 1 and %d", maxMaintenanceWindows),
+		)
+	}
+
+	windows, err := maintenanceWindows(cfg, loc, fromTime, int(count))
+	if err != nil {
+		return types.ListNull(elementType), function.NewArgumentFuncError(0, err.Error())
+	}
+
+	list, diags := maintenanceWindowsToList(windows)
+	if diags.HasError() {
+		return types.ListNull(elementType), function.FuncErrorFromDiags(ctx, diags)
+	}
+
+	return list, nil
+}
+
+// maintenanceScheduleConfigFromDynamic reads the schedule from an object or a
+// map with the attributes of `uptimekuma_maintenance`.
+func maintenanceScheduleConfigFromDynamic(
+	ctx context.Context,
+	value types.Dynamic,
+) (maintenanceScheduleConfig, *function.FuncError) {
+	var cfg maintenanceScheduleConfig
+
+	if value.IsNull() || value.IsUnderlyingValueNull() {
+		return cfg, function.NewArgumentFuncError(0, "strategy_config must not be null")
+	}
+
+	tfValue, err := value.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		return cfg, function.NewArgumentFuncError(0, err.Error())
+	}
+
+	attributes := map[string]tftypes.Value{}
+
+	err = tfValue.As(&attributes)
+	if err != nil {
+		return cfg, function.NewArgumentFuncError(0, "strategy_config must be an object or a map")
+	}
+
+	r := maintenanceConfigReader{attributes: attributes}
+
+	cfg.Strategy = r.string("strategy")
+	cfg.StartDate = r.string("start_date")
+	cfg.EndDate = r.string("end_date")
+	cfg.IntervalDay = r.int64("interval_day")
+	cfg.Cron = r.string("cron")
+	cfg.DurationMinutes = r.int64("duration_minutes")
+	cfg.StartTime = r.timeOfDay("start_time")
+	cfg.EndTime = r.timeOfDay("end_time")
+
+	for _, weekday := range r.list("weekdays") {
+		cfg.Weekdays = append(cfg.Weekdays, r.number("weekdays", weekday))
+	}
+
+	for _, day := range r.list("days_of_month") {
+		var s string
+
+		// Numbers are accepted as well, e.g. [1, 15].
+		if day.As(&s) != nil {
+			s = strconv.FormatInt(r.number("days_of_month", day), 10)
+		}
+
+		cfg.DaysOfMonth = append(cfg.DaysOfMonth, s)
+	}
+
+	if r.err != nil {
+		return cfg, function.NewArgumentFuncError(0, r.err.Error())
+	}
+
+	if cfg.Strategy == "" {
+		return cfg, function.NewArgumentFuncError(0, "strategy_config must contain strategy")
+	}
+
+	return cfg, nil
+}
+
+// maintenanceConfigReader reads the attributes of the strategy_config
+// argument. The first error is kept in err.
+type maintenanceConfigReader struct {
+	attributes map[string]tftypes.Value
+	err        error
+}
+
+func (r *maintenanceConfigReader) fail(format string, args ...any) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (r *maintenanceConfigReader) value(name string) (tftypes.Value, bool) {
+	v, ok := r.attributes[name]
+	if !ok || v.IsNull() {
+		return tftypes.Value{}, false
+	}
+
+	if !v.IsKnown() {
+		r.fail("%s must be known", name)
+		return tftypes.Value{}, false
+	}
+
+	return v, true
+}
+
+func (r *maintenanceConfigReader) string(name string) string {
+	v, ok := r.value(name)
+	if !ok {
+		return ""
+	}
+
+	var s string
+
+	err := v.As(&s)
+	if err != nil {
+		r.fail("%s must be a string", name)
+	}
+
+	return s
+}
+
+func (r *maintenanceConfigReader) int64(name string) int64 {
+	v, ok := r.value(name)
+	if !ok {
+		return 0
+	}
+
+	return r.number(name, v)
+}
+
+func (r *maintenanceConfigReader) number(name string, v tftypes.Value) int64 {
+	var n big.Float
+
+	err := v.As(&n)
+	if err != nil {
+		r.fail("%s must be a number", name)
+		return 0
+	}
+
+	i, _ := n.Int64()
+
+	return i
+}
+
+func (r *maintenanceConfigReader) list(name string) []tftypes.Value {
+	v, ok := r.value(name)
+	if !ok {
+		return nil
+	}
+
+	var elements []tftypes.Value
+
+	err := v.As(&elements)
+	if err != nil {
+		r.fail("%s must be a list", name)
+	}
+
+	return elements
+}
+
+func (r *maintenanceConfigReader) timeOfDay(name string) *maintenanceTimeOfDay {
+	v, ok := r.value(name)
+	if !ok {
+		return nil
+	}
+
+	attributes := map[string]tftypes.Value{}
+
+	err := v.As(&attributes)
+	if err != nil {
+		r.fail("%s must be an object with hours and minutes", name)
+		return nil
+	}
+
+	nested := maintenanceConfigReader{attributes: attributes}
+	timeOfDay := &maintenanceTimeOfDay{
+		Hours:   nested.int64("hours"),
+		Minutes: nested.int64("minutes"),
+	}
+
+	if nested.err != nil {
+		r.fail("%s: %w", name, nested.err)
+	}
+
+	return timeOfDay
+}
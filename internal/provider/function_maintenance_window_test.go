@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestMaintenanceScheduleConfigFromDynamic(t *testing.T) {
+	timeOfDayType := map[string]attr.Type{"hours": types.NumberType, "minutes": types.NumberType}
+
+	startTime := types.ObjectValueMust(timeOfDayType, map[string]attr.Value{
+		"hours":   types.NumberValue(big.NewFloat(22)),
+		"minutes": types.NumberValue(big.NewFloat(30)),
+	})
+	endTime := types.ObjectValueMust(timeOfDayType, map[string]attr.Value{
+		"hours":   types.NumberValue(big.NewFloat(2)),
+		"minutes": types.NumberValue(big.NewFloat(0)),
+	})
+
+	value := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"strategy":      types.StringType,
+			"title":         types.StringType,
+			"days_of_month": types.TupleType{ElemTypes: []attr.Type{types.NumberType, types.StringType}},
+			"start_time":    types.ObjectType{AttrTypes: timeOfDayType},
+			"end_time":      types.ObjectType{AttrTypes: timeOfDayType},
+		},
+		map[string]attr.Value{
+			"strategy": types.StringValue("recurring-day-of-month"),
+			"title":    types.StringValue("ignored"),
+			"days_of_month": types.TupleValueMust(
+				[]attr.Type{types.NumberType, types.StringType},
+				[]attr.Value{types.NumberValue(big.NewFloat(15)), types.StringValue("lastDay1")},
+			),
+			"start_time": startTime,
+			"end_time":   endTime,
+		},
+	))
+
+	cfg, funcErr := maintenanceScheduleConfigFromDynamic(t.Context(), value)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %v", funcErr)
+	}
+
+	if cfg.Strategy != "recurring-day-of-month" || strings.Join(cfg.DaysOfMonth, ",") != "15,lastDay1" {
+		t.Errorf("config = %+v, want strategy and days of month", cfg)
+	}
+
+	if cfg.StartTime == nil || cfg.StartTime.Hours != 22 || cfg.StartTime.Minutes != 30 ||
+		cfg.EndTime == nil || cfg.EndTime.Hours != 2 {
+		t.Errorf("config = %+v, want start and end time", cfg)
+	}
+
+	_, funcErr = maintenanceScheduleConfigFromDynamic(t.Context(), types.DynamicValue(types.StringValue("cron")))
+	if funcErr == nil {
+		t.Error("expected error for a string")
+	}
+}
+
+func TestMaintenanceWindowsList(t *testing.T) {
+	cfg := maintenanceScheduleConfig{Strategy: "cron", Cron: "0 3 * * *", DurationMinutes: 60}
+
+	tests := []struct {
+		name     string
+		timezone string
+		from     string
+		count    int64
+		wantErr  string
+		wantLen  int
+	}{
+		{name: "valid", timezone: "Europe/Zurich", from: "2026-10-19T00:00:00Z", count: 3, wantLen: 3},
+		{name: "same as server", timezone: "SAME_AS_SERVER", from: "2026-10-19T00:00:00Z", count: 3,
+			wantErr: "can not be resolved locally"},
+		{name: "invalid from", timezone: "UTC", from: "2026-10-19", count: 3, wantErr: "RFC3339"},
+		{name: "count too small", timezone: "UTC", from: "2026-10-19T00:00:00Z", count: 0,
+			wantErr: "count must be between"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, funcErr := maintenanceWindowsList(t.Context(), cfg, tt.timezone, tt.from, tt.count)
+			if tt.wantErr != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want error containing %q", funcErr, tt.wantErr)
+				}
+
+				return
+			}
+
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %v", funcErr)
+			}
+
+			if len(list.Elements()) != tt.wantLen {
+				t.Errorf("got %d windows, want %d", len(list.Elements()), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestAccMaintenanceWindowsFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  windows = provider::uptimekuma::maintenance_windows({
+    strategy   = "recurring-weekday"
+    weekdays   = [7]
+    start_time = { hours = 9, minutes = 0, seconds = 0 }
+    end_time   = { hours = 11, minutes = 0, seconds = 0 }
+  }, "Europe/Zurich", "2026-10-19T00:00:00Z", 2)
+}
+
+output "first" {
+  value = local.windows[0].start_date
+}
+
+output "second" {
+  value = local.windows[1].start_date
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("first", "2026-10-25T08:00:00Z"),
+					resource.TestCheckOutput("second", "2026-11-01T08:00:00Z"),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::uptimekuma::maintenance_windows(
+    { strategy = "cron", cron = "0 3 * * *" }, "SAME_AS_SERVER", "2026-10-19T00:00:00Z", 1
+  )
+}
+`,
+				ExpectError: regexp.MustCompile("can not be resolved locally"),
+			},
+		},
+	})
+}
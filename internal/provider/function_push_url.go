@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &PushURLFunction{}
+
+// NewPushURLFunction returns a new instance of the push URL function.
+func NewPushURLFunction() function.Function {
+	return &PushURLFunction{}
+}
+
+// PushURLFunction defines the function implementation.
+type PushURLFunction struct{}
+
+// Metadata returns the metadata for the function.
+func (*PushURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "push_url"
+}
+
+// Definition returns the definition for the function.
+func (*PushURLFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Build the push URL of a push monitor",
+		MarkdownDescription: "Returns the URL used to report heartbeats to a push monitor in the form " +
+			"`{base}/api/push/{push_token}?status=up&msg=OK&ping=`. " +
+			"The push token and the query parameters are escaped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "base",
+				MarkdownDescription: "Base URL of the Uptime Kuma instance, e.g. `https://uptime.example.com`",
+			},
+			function.StringParameter{
+				Name:                "push_token",
+				MarkdownDescription: "Push token of the monitor, e.g. `uptimekuma_monitor_push.example.push_token`",
+			},
+			function.StringParameter{
+				Name:                "status",
+				MarkdownDescription: "Reported status, either `up` or `down`. Defaults to `up` when `null`.",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "msg",
+				MarkdownDescription: "Reported message. Defaults to `OK` when `null`.",
+				AllowNullValue:      true,
+			},
+			function.Float64Parameter{
+				Name:                "ping",
+				MarkdownDescription: "Reported response time in milliseconds. Left empty when `null`.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the push URL.
+func (*PushURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		base, token string
+		status, msg types.String
+		ping        types.Float64
+	)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &base, &token, &status, &msg, &ping))
+	if resp.Error != nil {
+		return
+	}
+
+	result, funcErr := pushURL(base, token, status, msg, ping)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func pushURL(
+	base string,
+	token string,
+	status types.String,
+	msg types.String,
+	ping types.Float64,
+) (string, *function.FuncError) {
+	baseURL, err := parseBaseURL(base)
+	if err != nil {
+		return "", function.NewArgumentFuncError(0, err.Error())
+	}
+
+	if strings.TrimSpace(token) == "" || strings.ContainsAny(token, " \t\r\n") {
+		return "", function.NewArgumentFuncError(1, "push token must not be empty or contain whitespace")
+	}
+
+	statusValue := "up"
+	if !status.IsNull() {
+		statusValue = status.ValueString()
+	}
+
+	if statusValue != "up" && statusValue != "down" {
+		return "", function.NewArgumentFuncError(2, fmt.Sprintf("status %q must be either up or down", statusValue))
+	}
+
+	msgValue := "OK"
+	if !msg.IsNull() {
+		msgValue = msg.ValueString()
+	}
+
+	pingValue := ""
+	if !ping.IsNull() {
+		if ping.ValueFloat64() < 0 {
+			return "", function.NewArgumentFuncError(4, "ping must not be negative")
+		}
+
+		pingValue = strconv.FormatFloat(ping.ValueFloat64(), 'f', -1, 64)
+	}
+
+	// Keep the parameter order of the documented push URL format instead of
+	// the sorted order produced by url.Values.
+	query := "status=" + url.QueryEscape(statusValue) +
+		"&msg=" + url.QueryEscape(msgValue) +
+		"&ping=" + pingValue
+
+	return joinURL(baseURL, "api", "push", url.PathEscape(token)) + "?" + query, nil
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPushURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		token     string
+		status    types.String
+		msg       types.String
+		ping      types.Float64
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "defaults",
+			base:     "https://uptime.example.com",
+			token:    "abc123",
+			status:   types.StringNull(),
+			msg:      types.StringNull(),
+			ping:     types.Float64Null(),
+			expected: "https://uptime.example.com/api/push/abc123?status=up&msg=OK&ping=",
+		},
+		{
+			name:     "escaped values",
+			base:     "https://example.com/kuma/",
+			token:    "a/b",
+			status:   types.StringValue("down"),
+			msg:      types.StringValue("disk full & 95%"),
+			ping:     types.Float64Value(12.5),
+			expected: "https://example.com/kuma/api/push/a%2Fb?status=down&msg=disk+full+%26+95%25&ping=12.5",
+		},
+		{
+			name:      "invalid status",
+			base:      "https://example.com",
+			token:     "abc123",
+			status:    types.StringValue("pending"),
+			msg:       types.StringNull(),
+			ping:      types.Float64Null(),
+			expectErr: true,
+		},
+		{
+			name:      "empty token",
+			base:      "https://example.com",
+			token:     " ",
+			status:    types.StringNull(),
+			msg:       types.StringNull(),
+			ping:      types.Float64Null(),
+			expectErr: true,
+		},
+		{
+			name:      "negative ping",
+			base:      "https://example.com",
+			token:     "abc123",
+			status:    types.StringNull(),
+			msg:       types.StringNull(),
+			ping:      types.Float64Value(-1),
+			expectErr: true,
+		},
+		{
+			name:      "invalid base",
+			base:      "example.com",
+			token:     "abc123",
+			status:    types.StringNull(),
+			msg:       types.StringNull(),
+			ping:      types.Float64Null(),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, funcErr := pushURL(tt.base, tt.token, tt.status, tt.msg, tt.ping)
+			if tt.expectErr {
+				if funcErr == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+
+				return
+			}
+
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %v", funcErr)
+			}
+
+			if got != tt.expected {
+				t.Errorf("pushURL() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAccPushURLFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::uptimekuma::push_url("https://uptime.example.com", "abc123", null, "All good", 42)
+}
+`,
+				Check: resource.TestCheckOutput(
+					"test",
+					"https://uptime.example.com/api/push/abc123?status=up&msg=All+good&ping=42",
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::uptimekuma::push_url("https://uptime.example.com", "abc123", "unknown", null, null)
+}
+`,
+				ExpectError: regexp.MustCompile("must be either up or down"),
+			},
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &StatusPageURLFunction{}
+
+// NewStatusPageURLFunction returns a new instance of the status page URL function.
+func NewStatusPageURLFunction() function.Function {
+	return &StatusPageURLFunction{}
+}
+
+// StatusPageURLFunction defines the function implementation.
+type StatusPageURLFunction struct{}
+
+// Metadata returns the metadata for the function.
+func (*StatusPageURLFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "status_page_url"
+}
+
+// Definition returns the definition for the function.
+func (*StatusPageURLFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the public URL of a status page",
+		MarkdownDescription: "Returns the public URL of a status page in the form `{base}/status/{slug}`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "base",
+				MarkdownDescription: "Base URL of the Uptime Kuma instance, e.g. `https://uptime.example.com`",
+			},
+			function.StringParameter{
+				Name:                "slug",
+				MarkdownDescription: "Slug of the status page",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the status page URL.
+func (*StatusPageURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var base, slug string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &base, &slug))
+	if resp.Error != nil {
+		return
+	}
+
+	result, funcErr := statusPageURL(base, slug)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func statusPageURL(base string, slug string) (string, *function.FuncError) {
+	baseURL, err := parseBaseURL(base)
+	if err != nil {
+		return "", function.NewArgumentFuncError(0, err.Error())
+	}
+
+	err = validateStatusPageSlug(slug)
+	if err != nil {
+		return "", function.NewArgumentFuncError(1, err.Error())
+	}
+
+	return joinURL(baseURL, "status", slug), nil
+}
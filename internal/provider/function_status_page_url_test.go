@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestStatusPageURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		slug      string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "simple",
+			base:     "https://uptime.example.com",
+			slug:     "main",
+			expected: "https://uptime.example.com/status/main",
+		},
+		{
+			name:     "sub path with trailing slash",
+			base:     "https://example.com/kuma/",
+			slug:     "public-api",
+			expected: "https://example.com/kuma/status/public-api",
+		},
+		{name: "invalid scheme", base: "ftp://example.com", slug: "main", expectErr: true},
+		{name: "missing host", base: "https://", slug: "main", expectErr: true},
+		{name: "query in base", base: "https://example.com?x=1", slug: "main", expectErr: true},
+		{name: "uppercase slug", base: "https://example.com", slug: "Main", expectErr: true},
+		{name: "slug with slash", base: "https://example.com", slug: "a/b", expectErr: true},
+		{name: "empty slug", base: "https://example.com", slug: "", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, funcErr := statusPageURL(tt.base, tt.slug)
+			if tt.expectErr {
+				if funcErr == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+
+				return
+			}
+
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %v", funcErr)
+			}
+
+			if got != tt.expected {
+				t.Errorf("statusPageURL() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAccStatusPageURLFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::uptimekuma::status_page_url("https://uptime.example.com/", "main")
+}
+`,
+				Check: resource.TestCheckOutput("test", "https://uptime.example.com/status/main"),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::uptimekuma::status_page_url("https://uptime.example.com", "Not A Slug")
+}
+`,
+				ExpectError: regexp.MustCompile("must only contain lowercase letters"),
+			},
+		},
+	})
+}
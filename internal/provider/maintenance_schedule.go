@@ -0,0 +1,515 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Embed the time zone database, so windows do not depend on the host.
+)
+
+// cronSearchDays limits the search for the next run of a cron expression. It
+// covers expressions, which only match on February 29.
+const cronSearchDays = 8 * 366
+
+// cronExpression is a parsed cron expression with the semantics of croner,
+// the cron library used by Uptime Kuma.
+type cronExpression struct {
+	seconds     [60]bool
+	minutes     [60]bool
+	hours       [24]bool
+	daysOfMonth [32]bool
+	months      [13]bool
+	weekdays    [7]bool
+
+	lastDayOfMonth bool
+
+	// anyDayOfMonth and anyWeekday are set for wildcards. If neither of them
+	// is a wildcard, a day matches, if it matches either of them.
+	anyDayOfMonth bool
+	anyWeekday    bool
+}
+
+// cronField describes the values of a field of a cron expression.
+type cronField struct {
+	name       string
+	minValue   int
+	maxValue   int
+	names      []string
+	nameOffset int
+}
+
+// cronNickname returns the cron expression of a nickname like `@daily`.
+func cronNickname(expr string) (string, bool) {
+	switch strings.ToLower(expr) {
+	case "@yearly", "@annually":
+		return "0 0 1 1 *", true
+	case "@monthly":
+		return "0 0 1 * *", true
+	case "@weekly":
+		return "0 0 * * 0", true
+	case "@daily", "@midnight":
+		return "0 0 * * *", true
+	case "@hourly":
+		return "0 * * * *", true
+	default:
+		return "", false
+	}
+}
+
+// parseCronExpression parses a cron expression with 5 fields or 6 fields,
+// the first being the seconds. `L` is supported for the last day of the
+// month, other extensions of croner like `W` and `#` are not.
+func parseCronExpression(expr string) (*cronExpression, error) {
+	expr = strings.TrimSpace(expr)
+	if nickname, ok := cronNickname(expr); ok {
+		expr = nickname
+	}
+
+	fields := strings.Fields(expr)
+
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron expression %q must have 5 or 6 fields", expr)
+	}
+
+	c := &cronExpression{
+		anyDayOfMonth: fields[3] == "*" || fields[3] == "?",
+		anyWeekday:    fields[5] == "*" || fields[5] == "?",
+	}
+
+	err := parseCronField(fields[0], cronField{name: "second", maxValue: 59}, c.seconds[:])
+	if err != nil {
+		return nil, err
+	}
+
+	err = parseCronField(fields[1], cronField{name: "minute", maxValue: 59}, c.minutes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	err = parseCronField(fields[2], cronField{name: "hour", maxValue: 23}, c.hours[:])
+	if err != nil {
+		return nil, err
+	}
+
+	var daysOfMonth []string
+
+	for part := range strings.SplitSeq(fields[3], ",") {
+		if strings.EqualFold(part, "L") {
+			c.lastDayOfMonth = true
+			continue
+		}
+
+		daysOfMonth = append(daysOfMonth, part)
+	}
+
+	if len(daysOfMonth) > 0 {
+		err = parseCronField(
+			strings.Join(daysOfMonth, ","),
+			cronField{name: "day of month", minValue: 1, maxValue: 31},
+			c.daysOfMonth[:],
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = parseCronField(fields[4], cronField{
+		name:       "month",
+		minValue:   1,
+		maxValue:   12,
+		names:      []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"},
+		nameOffset: 1,
+	}, c.months[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Sunday is 0 or 7.
+	var weekdays [8]bool
+
+	err = parseCronField(fields[5], cronField{
+		name:     "weekday",
+		maxValue: 7,
+		names:    []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"},
+	}, weekdays[:])
+	if err != nil {
+		return nil, err
+	}
+
+	copy(c.weekdays[:], weekdays[:7])
+	c.weekdays[0] = c.weekdays[0] || weekdays[7]
+
+	return c, nil
+}
+
+// parseCronField sets the values of the field, a list of values, ranges and
+// steps, in set.
+func parseCronField(field string, f cronField, set []bool) error {
+	for part := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+
+		if hasStep {
+			var err error
+
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return fmt.Errorf("invalid step %q in %s field %q", stepPart, f.name, field)
+			}
+		}
+
+		first, last := f.minValue, f.maxValue
+
+		switch {
+		case rangePart == "*" || rangePart == "?":
+		case strings.Contains(rangePart, "-"):
+			from, to, _ := strings.Cut(rangePart, "-")
+
+			var err error
+
+			first, err = parseCronValue(from, f)
+			if err != nil {
+				return err
+			}
+
+			last, err = parseCronValue(to, f)
+			if err != nil {
+				return err
+			}
+
+			if first > last {
+				return fmt.Errorf("invalid range %q in %s field %q", rangePart, f.name, field)
+			}
+
+		default:
+			var err error
+
+			first, err = parseCronValue(rangePart, f)
+			if err != nil {
+				return err
+			}
+
+			if !hasStep {
+				last = first
+			}
+		}
+
+		for v := first; v <= last; v += step {
+			set[v] = true
+		}
+	}
+
+	return nil
+}
+
+// parseCronValue parses a single number or name of a field.
+func parseCronValue(value string, f cronField) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return i + f.nameOffset, nil
+		}
+	}
+
+	v, err := strconv.Atoi(value)
+	if err != nil || v < f.minValue || v > f.maxValue {
+		return 0, fmt.Errorf("unsupported value %q in %s field, must be between %d and %d",
+			value, f.name, f.minValue, f.maxValue)
+	}
+
+	return v, nil
+}
+
+// matchesDay reports, whether the expression matches the date.
+func (c *cronExpression) matchesDay(date time.Time) bool {
+	if !c.months[date.Month()] {
+		return false
+	}
+
+	lastDay := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	dayOfMonth := c.daysOfMonth[date.Day()] || (c.lastDayOfMonth && date.Day() == lastDay)
+	weekday := c.weekdays[date.Weekday()]
+
+	if !c.anyDayOfMonth && !c.anyWeekday {
+		return dayOfMonth || weekday
+	}
+
+	return dayOfMonth && weekday
+}
+
+// next returns the first time after t, at which the expression matches in
+// the location loc. Times skipped by a daylight saving time change are moved
+// forward by the length of the change, times repeated by it only match once.
+func (c *cronExpression) next(t time.Time, loc *time.Location) (time.Time, bool) {
+	year, month, day := t.In(loc).Date()
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	for range cronSearchDays {
+		if c.matchesDay(date) {
+			next, ok := c.nextOnDay(date, t, loc)
+			if ok {
+				return next, true
+			}
+		}
+
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, false
+}
+
+// nextOnDay returns the first time of the date after t, at which the
+// expression matches.
+func (c *cronExpression) nextOnDay(date time.Time, t time.Time, loc *time.Location) (time.Time, bool) {
+	for hour := range 24 {
+		if !c.hours[hour] {
+			continue
+		}
+
+		for minute := range 60 {
+			if !c.minutes[minute] {
+				continue
+			}
+
+			for second := range 60 {
+				if !c.seconds[second] {
+					continue
+				}
+
+				candidate := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, second, 0, loc)
+				if candidate.After(t) {
+					return candidate, true
+				}
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// maintenanceTimeOfDay is the time of day of a recurring maintenance.
+// Uptime Kuma ignores the seconds.
+type maintenanceTimeOfDay struct {
+	Hours   int64
+	Minutes int64
+}
+
+// maintenanceScheduleConfig holds the settings of a maintenance, which define
+// its windows.
+type maintenanceScheduleConfig struct {
+	Strategy        string
+	StartDate       string
+	EndDate         string
+	IntervalDay     int64
+	Weekdays        []int64
+	DaysOfMonth     []string
+	Cron            string
+	DurationMinutes int64
+	StartTime       *maintenanceTimeOfDay
+	EndTime         *maintenanceTimeOfDay
+}
+
+// maintenanceWindow is a single window of a maintenance.
+type maintenanceWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// maintenanceLocation returns the location of the timezone option of a
+// maintenance.
+func maintenanceLocation(timezone string) (*time.Location, error) {
+	switch timezone {
+	case "UTC":
+		return time.UTC, nil
+	case "SAME_AS_SERVER", "":
+		return nil, fmt.Errorf("timezone %q depends on the server and can not be resolved locally", timezone)
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+
+	return loc, nil
+}
+
+// maintenanceCron returns the cron expression and the duration of the windows
+// of a recurring or cron maintenance, generated the same way as by Uptime Kuma.
+func maintenanceCron(cfg maintenanceScheduleConfig) (string, time.Duration, error) {
+	if cfg.Strategy == "cron" {
+		if cfg.Cron == "" {
+			return "", 0, errors.New("cron is required for cron strategy")
+		}
+
+		return cfg.Cron, time.Duration(cfg.DurationMinutes) * time.Minute, nil
+	}
+
+	if cfg.StartTime == nil || cfg.EndTime == nil {
+		return "", 0, fmt.Errorf("start_time and end_time are required for %s strategy", cfg.Strategy)
+	}
+
+	start := time.Duration(cfg.StartTime.Hours)*time.Hour + time.Duration(cfg.StartTime.Minutes)*time.Minute
+	end := time.Duration(cfg.EndTime.Hours)*time.Hour + time.Duration(cfg.EndTime.Minutes)*time.Minute
+
+	// Windows ending before they start span midnight.
+	duration := end - start
+	if duration < 0 {
+		duration += 24 * time.Hour
+	}
+
+	timeOfDay := fmt.Sprintf("%d %d", cfg.StartTime.Minutes, cfg.StartTime.Hours)
+
+	switch cfg.Strategy {
+	case "recurring-interval":
+		return timeOfDay + " * * *", duration, nil
+
+	case "recurring-weekday":
+		weekdays := make([]string, 0, len(cfg.Weekdays))
+		for _, weekday := range cfg.Weekdays {
+			if weekday < 1 || weekday > 7 {
+				return "", 0, fmt.Errorf("weekday %d must be between 1 (Monday) and 7 (Sunday)", weekday)
+			}
+
+			weekdays = append(weekdays, strconv.FormatInt(weekday, 10))
+		}
+
+		if len(weekdays) == 0 {
+			return "", 0, errors.New("weekdays is required for recurring-weekday strategy")
+		}
+
+		return timeOfDay + " * * " + strings.Join(weekdays, ","), duration, nil
+
+	case "recurring-day-of-month":
+		days, err := maintenanceCronDaysOfMonth(cfg.DaysOfMonth)
+		if err != nil {
+			return "", 0, err
+		}
+
+		return timeOfDay + " " + days + " * *", duration, nil
+
+	default:
+		return "", 0, fmt.Errorf("strategy %q has no recurring windows", cfg.Strategy)
+	}
+}
+
+// maintenanceCronDaysOfMonth returns the day of month field of the cron
+// expression. Like Uptime Kuma, lastDay1 is the last day of the month and
+// lastDay2 to lastDay4 are ignored, as cron does not support them.
+func maintenanceCronDaysOfMonth(daysOfMonth []string) (string, error) {
+	days := make([]string, 0, len(daysOfMonth))
+
+	for _, day := range daysOfMonth {
+		switch day {
+		case "lastDay1":
+			day = "L"
+		case "lastDay2", "lastDay3", "lastDay4":
+			continue
+		default:
+			v, err := strconv.Atoi(day)
+			if err != nil || v < 1 || v > 31 {
+				return "", fmt.Errorf("day of month %q must be between 1 and 31 or lastDay1 to lastDay4", day)
+			}
+		}
+
+		if !slices.Contains(days, day) {
+			days = append(days, day)
+		}
+	}
+
+	if len(days) == 0 {
+		return "", errors.New("days_of_month must contain a day supported by Uptime Kuma (1-31 or lastDay1)")
+	}
+
+	return strings.Join(days, ","), nil
+}
+
+// maintenanceWindows returns up to count windows of the maintenance, which end
+// after from. A window in progress at from is included. Windows of the
+// recurring-interval strategy repeat every interval_day days after the first
+// window, at the same local time.
+func maintenanceWindows(
+	cfg maintenanceScheduleConfig,
+	loc *time.Location,
+	from time.Time,
+	count int,
+) ([]maintenanceWindow, error) {
+	switch cfg.Strategy {
+	case "manual":
+		return []maintenanceWindow{}, nil
+
+	case "single":
+		start, err := time.Parse(time.RFC3339, cfg.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_date: %w", err)
+		}
+
+		end, err := time.Parse(time.RFC3339, cfg.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_date: %w", err)
+		}
+
+		if count < 1 || !end.After(from) {
+			return []maintenanceWindow{}, nil
+		}
+
+		return []maintenanceWindow{{Start: start, End: end}}, nil
+	}
+
+	expr, duration, err := maintenanceCron(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cron, err := parseCronExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	intervalDay := 1
+	if cfg.Strategy == "recurring-interval" {
+		if cfg.IntervalDay < 1 {
+			return nil, errors.New("interval_day must be at least 1")
+		}
+
+		intervalDay = int(cfg.IntervalDay)
+	}
+
+	windows := []maintenanceWindow{}
+
+	t := from.Add(-duration)
+	for len(windows) < count {
+		start, ok := cron.next(t, loc)
+		if !ok {
+			break
+		}
+
+		t = start
+
+		if len(windows) > 0 && calendarDaysBetween(windows[0].Start, start, loc)%intervalDay != 0 {
+			continue
+		}
+
+		windows = append(windows, maintenanceWindow{Start: start, End: start.Add(duration)})
+	}
+
+	return windows, nil
+}
+
+// calendarDaysBetween returns the number of calendar days between a and b in
+// the location loc.
+func calendarDaysBetween(a time.Time, b time.Time, loc *time.Location) int {
+	ay, am, ad := a.In(loc).Date()
+	by, bm, bd := b.In(loc).Date()
+
+	days := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC))
+
+	return int(days / (24 * time.Hour))
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCronExpression(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "five fields", expr: "30 2 * * 1-5"},
+		{name: "six fields", expr: "0 30 2 * * *"},
+		{name: "steps and lists", expr: "*/15 8-18/2 1,15 * *"},
+		{name: "names", expr: "0 0 * jan-mar MON,fri"},
+		{name: "last day of month", expr: "0 0 L * *"},
+		{name: "sunday as seven", expr: "0 0 * * 7"},
+		{name: "nickname", expr: "@daily"},
+		{name: "too few fields", expr: "0 0 * *", wantErr: "must have 5 or 6 fields"},
+		{name: "out of range", expr: "0 24 * * *", wantErr: "hour field"},
+		{name: "invalid step", expr: "*/0 * * * *", wantErr: "invalid step"},
+		{name: "invalid range", expr: "0 0 10-5 * *", wantErr: "invalid range"},
+		{name: "nth weekday", expr: "0 0 * * 1#2", wantErr: "weekday field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseCronExpression(tt.expr)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want error containing %q", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCronExpressionDayOfMonthOrWeekday(t *testing.T) {
+	// Like croner, a day matches either restricted day of month or weekday.
+	cron, err := parseCronExpression("0 0 13 * 5")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	from := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	var got []string
+
+	for range 3 {
+		next, ok := cron.next(from, time.UTC)
+		if !ok {
+			t.Fatal("expected next run")
+		}
+
+		got = append(got, next.Format(time.DateOnly))
+		from = next
+	}
+
+	want := []string{"2026-10-02", "2026-10-09", "2026-10-13"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("next runs = %v, want %v", got, want)
+	}
+}
+
+func TestMaintenanceWindows(t *testing.T) {
+	zurich, err := maintenanceLocation("Europe/Zurich")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nineToEleven := func(cfg maintenanceScheduleConfig) maintenanceScheduleConfig {
+		cfg.StartTime = &maintenanceTimeOfDay{Hours: 9}
+		cfg.EndTime = &maintenanceTimeOfDay{Hours: 11}
+
+		return cfg
+	}
+
+	tests := []struct {
+		name    string
+		cfg     maintenanceScheduleConfig
+		loc     *time.Location
+		from    string
+		count   int
+		want    []string
+		wantErr string
+	}{
+		{
+			name:  "manual",
+			cfg:   maintenanceScheduleConfig{Strategy: "manual"},
+			loc:   time.UTC,
+			from:  "2026-10-19T00:00:00Z",
+			count: 3,
+		},
+		{
+			name: "single",
+			cfg: maintenanceScheduleConfig{
+				Strategy:  "single",
+				StartDate: "2026-10-20T08:00:00Z",
+				EndDate:   "2026-10-20T10:00:00Z",
+			},
+			loc:   time.UTC,
+			from:  "2026-10-19T00:00:00Z",
+			count: 3,
+			want:  []string{"2026-10-20T08:00:00Z/2026-10-20T10:00:00Z"},
+		},
+		{
+			name: "single ended",
+			cfg: maintenanceScheduleConfig{
+				Strategy:  "single",
+				StartDate: "2026-10-20T08:00:00Z",
+				EndDate:   "2026-10-20T10:00:00Z",
+			},
+			loc:   time.UTC,
+			from:  "2026-10-21T00:00:00Z",
+			count: 3,
+		},
+		{
+			name:  "recurring interval",
+			cfg:   nineToEleven(maintenanceScheduleConfig{Strategy: "recurring-interval", IntervalDay: 3}),
+			loc:   time.UTC,
+			from:  "2026-10-19T12:00:00Z",
+			count: 3,
+			want: []string{
+				"2026-10-20T09:00:00Z/2026-10-20T11:00:00Z",
+				"2026-10-23T09:00:00Z/2026-10-23T11:00:00Z",
+				"2026-10-26T09:00:00Z/2026-10-26T11:00:00Z",
+			},
+		},
+		{
+			name:  "window in progress",
+			cfg:   nineToEleven(maintenanceScheduleConfig{Strategy: "recurring-interval", IntervalDay: 1}),
+			loc:   time.UTC,
+			from:  "2026-10-19T10:00:00Z",
+			count: 2,
+			want: []string{
+				"2026-10-19T09:00:00Z/2026-10-19T11:00:00Z",
+				"2026-10-20T09:00:00Z/2026-10-20T11:00:00Z",
+			},
+		},
+		{
+			name:  "recurring weekday",
+			cfg:   nineToEleven(maintenanceScheduleConfig{Strategy: "recurring-weekday", Weekdays: []int64{2, 7}}),
+			loc:   time.UTC,
+			from:  "2026-10-19T00:00:00Z",
+			count: 3,
+			want: []string{
+				"2026-10-20T09:00:00Z/2026-10-20T11:00:00Z",
+				"2026-10-25T09:00:00Z/2026-10-25T11:00:00Z",
+				"2026-10-27T09:00:00Z/2026-10-27T11:00:00Z",
+			},
+		},
+		{
+			name: "recurring day of month",
+			cfg: nineToEleven(maintenanceScheduleConfig{
+				Strategy:    "recurring-day-of-month",
+				DaysOfMonth: []string{"15", "lastDay1", "lastDay2"},
+			}),
+			loc:   time.UTC,
+			from:  "2026-02-01T00:00:00Z",
+			count: 3,
+			want: []string{
+				"2026-02-15T09:00:00Z/2026-02-15T11:00:00Z",
+				"2026-02-28T09:00:00Z/2026-02-28T11:00:00Z",
+				"2026-03-15T09:00:00Z/2026-03-15T11:00:00Z",
+			},
+		},
+		{
+			name: "across midnight",
+			cfg: maintenanceScheduleConfig{
+				Strategy:  "recurring-weekday",
+				Weekdays:  []int64{1},
+				StartTime: &maintenanceTimeOfDay{Hours: 23, Minutes: 30},
+				EndTime:   &maintenanceTimeOfDay{Hours: 1},
+			},
+			loc:   time.UTC,
+			from:  "2026-10-19T00:00:00Z",
+			count: 1,
+			want:  []string{"2026-10-19T23:30:00Z/2026-10-20T01:00:00Z"},
+		},
+		{
+			name:  "cron",
+			cfg:   maintenanceScheduleConfig{Strategy: "cron", Cron: "0 3 * * 0", DurationMinutes: 90},
+			loc:   time.UTC,
+			from:  "2026-10-19T00:00:00Z",
+			count: 2,
+			want: []string{
+				"2026-10-25T03:00:00Z/2026-10-25T04:30:00Z",
+				"2026-11-01T03:00:00Z/2026-11-01T04:30:00Z",
+			},
+		},
+		{
+			name:  "daylight saving time ends",
+			cfg:   nineToEleven(maintenanceScheduleConfig{Strategy: "recurring-interval", IntervalDay: 1}),
+			loc:   zurich,
+			from:  "2026-10-24T00:00:00Z",
+			count: 2,
+			want: []string{
+				"2026-10-24T07:00:00Z/2026-10-24T09:00:00Z",
+				"2026-10-25T08:00:00Z/2026-10-25T10:00:00Z",
+			},
+		},
+		{
+			name:  "time skipped by daylight saving time",
+			cfg:   maintenanceScheduleConfig{Strategy: "cron", Cron: "30 2 * * *", DurationMinutes: 30},
+			loc:   zurich,
+			from:  "2026-03-28T12:00:00Z",
+			count: 2,
+			want: []string{
+				"2026-03-29T01:30:00Z/2026-03-29T02:00:00Z",
+				"2026-03-30T00:30:00Z/2026-03-30T01:00:00Z",
+			},
+		},
+		{
+			name: "unsupported days of month",
+			cfg: nineToEleven(maintenanceScheduleConfig{
+				Strategy:    "recurring-day-of-month",
+				DaysOfMonth: []string{"lastDay2"},
+			}),
+			loc:     time.UTC,
+			from:    "2026-10-19T00:00:00Z",
+			count:   1,
+			wantErr: "days_of_month must contain a day supported by Uptime Kuma",
+		},
+		{
+			name:    "missing time range",
+			cfg:     maintenanceScheduleConfig{Strategy: "recurring-weekday", Weekdays: []int64{1}},
+			loc:     time.UTC,
+			from:    "2026-10-19T00:00:00Z",
+			count:   1,
+			wantErr: "start_time and end_time are required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, err := time.Parse(time.RFC3339, tt.from)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			windows, err := maintenanceWindows(tt.cfg, tt.loc, from, tt.count)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want error containing %q", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]string, len(windows))
+			for i, w := range windows {
+				got[i] = w.Start.UTC().Format(time.RFC3339) + "/" + w.End.UTC().Format(time.RFC3339)
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("maintenanceWindows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaintenanceLocation(t *testing.T) {
+	for _, timezone := range []string{"SAME_AS_SERVER", "", "Not/AZone"} {
+		_, err := maintenanceLocation(timezone)
+		if err == nil {
+			t.Errorf("maintenanceLocation(%q) expected error", timezone)
+		}
+	}
+}
@@ -5,14 +5,19 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	kuma "github.com/breml/go-uptime-kuma-client"
@@ -22,7 +27,9 @@ import (
 
 // Ensure UptimeKumaProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider = &UptimeKumaProvider{}
+	_ provider.Provider              = &UptimeKumaProvider{}
+	_ provider.ProviderWithActions   = &UptimeKumaProvider{}
+	_ provider.ProviderWithFunctions = &UptimeKumaProvider{}
 )
 
 // UptimeKumaProvider defines the provider implementation.
@@ -35,12 +42,14 @@ type UptimeKumaProvider struct {
 
 // UptimeKumaProviderModel describes the provider data model.
 type UptimeKumaProviderModel struct {
-	Endpoint          types.String `tfsdk:"endpoint"`
-	Username          types.String `tfsdk:"username"`
-	Password          types.String `tfsdk:"password"`
-	Timeout           types.String `tfsdk:"timeout"`
-	PerAttemptTimeout types.String `tfsdk:"per_attempt_timeout"`
-	MaxRetries        types.Int64  `tfsdk:"max_retries"`
+	Endpoint            types.String `tfsdk:"endpoint"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	Timeout             types.String `tfsdk:"timeout"`
+	PerAttemptTimeout   types.String `tfsdk:"per_attempt_timeout"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	AutoCreateTags      types.Bool   `tfsdk:"auto_create_tags"`
+	AutoCreateTagsColor types.String `tfsdk:"auto_create_tags_color"`
 }
 
 // Metadata returns the metadata for the provider.
@@ -94,6 +103,25 @@ func (*UptimeKumaProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				),
 				Optional: true,
 			},
+			"auto_create_tags": schema.BoolAttribute{
+				MarkdownDescription: "Create tags, which are referenced by `name` in the `tags` of a monitor, but do " +
+					"not exist yet. Defaults to `false`.",
+				Optional: true,
+			},
+			"auto_create_tags_color": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(
+					"Color of the tags created by `auto_create_tags` (hex color code, e.g., #RRGGBB). "+
+						"Defaults to `%s`.",
+					defaultAutoCreateTagsColor,
+				),
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^#[0-9A-Fa-f]{6}$|^#[0-9A-Fa-f]{3}$`),
+						"must be a valid hex color code (e.g., #RRGGBB or #RGB)",
+					),
+				},
+			},
 		},
 	}
 }
@@ -145,7 +173,7 @@ func (*UptimeKumaProvider) Configure(
 		return
 	}
 
-	kumaClient, err := client.New(context.Background(), &client.Config{
+	clientConfig := &client.Config{
 		Endpoint:             data.Endpoint.ValueString(),
 		Username:             data.Username.ValueString(),
 		Password:             data.Password.ValueString(),
@@ -154,7 +182,9 @@ func (*UptimeKumaProvider) Configure(
 		ConnectTimeout:       opts.connectTimeout,
 		PerAttemptTimeout:    opts.perAttemptTimeout,
 		MaxRetries:           opts.maxRetries,
-	})
+	}
+
+	kumaClient, err := client.New(context.Background(), clientConfig)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"failed to connect to Uptime Kuma",
@@ -171,12 +201,22 @@ func (*UptimeKumaProvider) Configure(
 	}()
 
 	pd := &providerData{
-		client:   kumaClient,
-		password: data.Password.ValueString(),
+		client:       kumaClient,
+		clientConfig: clientConfig,
+		password:     data.Password.ValueString(),
+		tagOptions: monitorTagOptions{
+			autoCreate: data.AutoCreateTags.ValueBool(),
+			color:      defaultAutoCreateTagsColor,
+		},
+	}
+
+	if !data.AutoCreateTagsColor.IsNull() {
+		pd.tagOptions.color = data.AutoCreateTagsColor.ValueString()
 	}
 
 	resp.DataSourceData = pd
 	resp.ResourceData = pd
+	resp.ActionData = pd
 }
 
 func connectionErrorDetail(endpoint string, err error) string {
@@ -257,6 +297,10 @@ func parseClientOptions(
 // description so the documented default cannot drift from the runtime one.
 const defaultMaxRetries = 3
 
+// defaultAutoCreateTagsColor is the color of tags created by
+// `auto_create_tags`, if `auto_create_tags_color` is not set.
+const defaultAutoCreateTagsColor = "#4B5563"
+
 // parseDurationAttribute parses a Go duration string from a Terraform string
 // attribute. Empty/null values yield a zero duration (meaning "use the default").
 // Negative values produce a diagnostic error.
@@ -370,15 +414,27 @@ func (*UptimeKumaProvider) Resources(_ context.Context) []func() resource.Resour
 		NewMonitorDockerResource,
 		NewMonitorMQTTResource,
 		NewMonitorSMTPResource,
+	)
+
+	// Offer write-only variants for the secrets of monitors and notifications.
+	resources = withWriteOnlySecrets(resources)
+
+	resources = append(
+		resources,
 		NewProxyResource,
 		NewTagResource,
 		NewDockerHostResource,
+		NewRemoteBrowserResource,
 		NewMaintenanceResource,
 		NewMaintenanceMonitorsResource,
 		NewMaintenanceStatusPagesResource,
+		NewMonitorNotificationResource,
+		NewMonitorTagResource,
+		NewNotificationPolicyResource,
 		NewSettingsResource,
 		NewStatusPageResource,
 		NewStatusPageIncidentResource,
+		NewStatusPageGroupResource,
 	)
 
 	return resources
@@ -482,6 +538,25 @@ func notificationResources() []func() resource.Resource {
 	}
 }
 
+// Actions returns the list of actions for the provider.
+func (*UptimeKumaProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewMonitorPauseAction,
+		NewMonitorClearHistoryAction,
+		NewNotificationTestAction,
+	}
+}
+
+// Functions returns the list of provider-defined functions.
+func (*UptimeKumaProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewPushURLFunction,
+		NewBadgeURLFunction,
+		NewStatusPageURLFunction,
+		NewMaintenanceWindowsFunction,
+	}
+}
+
 // DataSources returns the list of data sources for the provider.
 func (*UptimeKumaProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	dataSources := notificationDataSources()
@@ -520,11 +595,14 @@ func (*UptimeKumaProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewMonitorSMTPDataSource,
 		NewProxyDataSource,
 		NewDockerHostDataSource,
+		NewRemoteBrowserDataSource,
 		NewMaintenanceDataSource,
 		NewMaintenanceMonitorsDataSource,
 		NewMaintenanceStatusPagesDataSource,
 		NewSettingsDataSource,
 		NewStatusPageDataSource,
+		NewStatusPagesDataSource,
+		NewStatusPageIncidentsDataSource,
 	)
 
 	return dataSources
@@ -1,23 +1,48 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
 	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
 )
 
 // providerData holds the configured client and credentials passed from the
 // provider to each resource and data source via Configure.
 type providerData struct {
-	client   *kuma.Client
-	password string
+	client       *kuma.Client
+	clientConfig *client.Config
+	password     string
+	tagOptions   monitorTagOptions
+
+	// monitorNotificationsMu serializes changes to the notifications of a
+	// monitor, which are read and written back as a whole.
+	monitorNotificationsMu sync.Mutex
+
+	// monitorTagsMu serializes the creation of tags referenced by name, so
+	// monitors created in parallel do not create the same tag twice.
+	monitorTagsMu sync.Mutex
+
+	// statusPageGroupsMu serializes changes to the public groups of status
+	// pages, which are read and written back as a whole.
+	statusPageGroupsMu sync.Mutex
+
+	// remoteBrowsers is the session shared by the remote browser resources
+	// and data sources.
+	remoteBrowsers remoteBrowserSession
 }
 
-// configureClient extracts the Uptime Kuma client from provider data.
+// configureProviderData extracts the provider data passed to Configure.
 // Returns nil when provider data is nil (early call before Configure).
-func configureClient(pd any, diags *diag.Diagnostics) *kuma.Client {
+func configureProviderData(pd any, diags *diag.Diagnostics) *providerData {
 	if pd == nil {
 		return nil
 	}
@@ -35,5 +60,131 @@ func configureClient(pd any, diags *diag.Diagnostics) *kuma.Client {
 		return nil
 	}
 
+	return data
+}
+
+// configureClient extracts the Uptime Kuma client from provider data.
+// Returns nil when provider data is nil (early call before Configure).
+func configureClient(pd any, diags *diag.Diagnostics) *kuma.Client {
+	data := configureProviderData(pd, diags)
+	if data == nil {
+		return nil
+	}
+
 	return data.client
 }
+
+// configureMonitorTagOptions extracts the options for the tags of monitors
+// from provider data. Type errors are already reported by configureClient.
+func configureMonitorTagOptions(pd any) monitorTagOptions {
+	data, ok := pd.(*providerData)
+	if !ok {
+		return monitorTagOptions{}
+	}
+
+	options := data.tagOptions
+	options.createMu = &data.monitorTagsMu
+
+	return options
+}
+
+// updateMonitorNotifications adds and removes notifications of a monitor. The
+// monitor is read and written back with only its notifications changed.
+func (pd *providerData) updateMonitorNotifications(
+	ctx context.Context,
+	monitorID int64,
+	add []int64,
+	remove []int64,
+) error {
+	pd.monitorNotificationsMu.Lock()
+	defer pd.monitorNotificationsMu.Unlock()
+
+	mon, err := pd.client.GetMonitor(ctx, monitorID)
+	// Handle error.
+	if err != nil {
+		return err
+	}
+
+	notificationIDs := slices.DeleteFunc(slices.Clone(mon.NotificationIDs), func(id int64) bool {
+		return slices.Contains(remove, id)
+	})
+	for _, id := range add {
+		if !slices.Contains(notificationIDs, id) {
+			notificationIDs = append(notificationIDs, id)
+		}
+	}
+
+	if slices.Equal(notificationIDs, mon.NotificationIDs) {
+		return nil
+	}
+
+	mon.NotificationIDs = notificationIDs
+
+	return pd.client.UpdateMonitor(ctx, &mon)
+}
+
+// saveStatusPageGroups saves the status page with the groups in
+// sp.PublicGroupList merged into its current public groups. Current groups
+// with an ID in owned are removed, if they are not in sp.PublicGroupList.
+// All other groups of the status page are kept, as well as the current auto
+// refresh interval, if autoRefreshInterval is nil. It returns the saved groups
+// in the order of sp.PublicGroupList.
+func (pd *providerData) saveStatusPageGroups(
+	ctx context.Context,
+	sp *statuspage.StatusPage,
+	autoRefreshInterval *int64,
+	owned []int64,
+) ([]statuspage.PublicGroup, error) {
+	if pd.clientConfig == nil {
+		return nil, errors.New("provider connection settings are not available")
+	}
+
+	pd.statusPageGroupsMu.Lock()
+	defer pd.statusPageGroupsMu.Unlock()
+
+	data, err := client.GetStatusPageData(ctx, pd.clientConfig, sp.Slug)
+	// Handle error.
+	if err != nil {
+		return nil, err
+	}
+
+	merged, indices := mergeStatusPageGroups(data.PublicGroupList, owned, sp.PublicGroupList)
+
+	page := *sp
+	page.PublicGroupList = merged
+
+	if autoRefreshInterval == nil {
+		autoRefreshInterval = data.Config.AutoRefreshInterval
+	}
+
+	savedGroups, err := pd.saveStatusPage(ctx, &page, autoRefreshInterval)
+	// Handle error.
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]statuspage.PublicGroup, len(indices))
+	for i, index := range indices {
+		if index < len(savedGroups) {
+			groups[i] = savedGroups[index]
+		}
+	}
+
+	return groups, nil
+}
+
+// openSession opens a short-lived Socket.IO session with the connection
+// settings of the provider. It is used for server events not supported by
+// the Uptime Kuma client library. The session must be closed by the caller.
+func (pd *providerData) openSession(ctx context.Context, opts ...client.SessionOption) (*client.Session, error) {
+	if pd.clientConfig == nil {
+		return nil, errors.New("provider connection settings are not available")
+	}
+
+	session, err := client.NewSession(ctx, pd.clientConfig, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("open session: %w", err)
+	}
+
+	return session, nil
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
+)
+
+// remoteBrowserListTimeout limits the time to wait for the list of remote
+// browsers, which Uptime Kuma sends after the login.
+const remoteBrowserListTimeout = 10 * time.Second
+
+// errRemoteBrowserNotFound is returned, if the remote browser does not exist.
+var errRemoteBrowserNotFound = errors.New("remote browser not found")
+
+// remoteBrowserSession is the Socket.IO session shared by the remote browser
+// resources and data sources, so they do not log in for every operation.
+// Uptime Kuma sends the list of remote browsers after the login and after
+// every change, the latest list received is kept.
+type remoteBrowserSession struct {
+	mu       sync.Mutex
+	session  *client.Session
+	received chan struct{}
+
+	listMu sync.Mutex
+	list   []remoteBrowser
+}
+
+// openRemoteBrowserSession returns the shared remote browser session, which
+// is opened on first use and after a connection failure. The returned channel
+// is closed, once the list of remote browsers was received.
+func (pd *providerData) openRemoteBrowserSession(ctx context.Context) (*client.Session, <-chan struct{}, error) {
+	s := &pd.remoteBrowsers
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.session != nil {
+		return s.session, s.received, nil
+	}
+
+	received := make(chan struct{})
+	markReceived := sync.OnceFunc(func() {
+		close(received)
+	})
+
+	// The session outlives the operation, which opens it.
+	session, err := pd.openSession(context.WithoutCancel(ctx), client.WithEventHandler(
+		"remoteBrowserList",
+		func(browsers []remoteBrowser) {
+			s.listMu.Lock()
+			s.list = browsers
+			s.listMu.Unlock()
+
+			markReceived()
+		},
+	))
+	// Handle error.
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.session = session
+	s.received = received
+
+	return session, received, nil
+}
+
+// closeRemoteBrowserSession closes the shared remote browser session, unless
+// it was already replaced by a new session.
+func (pd *providerData) closeRemoteBrowserSession(session *client.Session) {
+	s := &pd.remoteBrowsers
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.session != session {
+		return
+	}
+
+	_ = session.Close()
+
+	s.session = nil
+	s.received = nil
+}
+
+// callRemoteBrowserEvent calls the event on the shared remote browser session.
+// If Uptime Kuma does not acknowledge the event, e.g. because the connection
+// was lost, the session is closed and opened again by the next call.
+func (pd *providerData) callRemoteBrowserEvent(ctx context.Context, event string, result any, args ...any) error {
+	session, _, err := pd.openRemoteBrowserSession(ctx)
+	// Handle error.
+	if err != nil {
+		return err
+	}
+
+	ack, err := session.Call(ctx, event, result, args...)
+	if err != nil && ack == (client.Ack{}) {
+		pd.closeRemoteBrowserSession(session)
+	}
+
+	return err
+}
+
+// remoteBrowserError returns an error wrapping errRemoteBrowserNotFound, if
+// the remote browser with the given ID is not in the list of remote browsers.
+// Otherwise, err is returned.
+func (pd *providerData) remoteBrowserError(ctx context.Context, id int64, err error) error {
+	browsers, listErr := getRemoteBrowsers(ctx, pd)
+	if listErr != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(browsers, func(rb remoteBrowser) bool { return rb.ID == id }) {
+		return fmt.Errorf("remote browser %d: %w", id, errRemoteBrowserNotFound)
+	}
+
+	return err
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
+)
+
+func TestRemoteBrowserError(t *testing.T) {
+	received := make(chan struct{})
+	close(received)
+
+	pd := &providerData{}
+	pd.remoteBrowsers.session = &client.Session{}
+	pd.remoteBrowsers.received = received
+	pd.remoteBrowsers.list = []remoteBrowser{{ID: 1, Name: "browserless", URL: "ws://browserless:3000"}}
+
+	errCall := errors.New("getRemoteBrowser: failed")
+
+	err := pd.remoteBrowserError(t.Context(), 2, errCall)
+	if !errors.Is(err, errRemoteBrowserNotFound) {
+		t.Errorf("error = %v, want %v", err, errRemoteBrowserNotFound)
+	}
+
+	err = pd.remoteBrowserError(t.Context(), 1, errCall)
+	if !errors.Is(err, errCall) || errors.Is(err, errRemoteBrowserNotFound) {
+		t.Errorf("error = %v, want %v", err, errCall)
+	}
+
+	// A session replaced in the meantime is not closed.
+	pd.closeRemoteBrowserSession(&client.Session{})
+
+	if pd.remoteBrowsers.session == nil {
+		t.Error("session closed, want it kept")
+	}
+}
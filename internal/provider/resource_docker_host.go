@@ -3,8 +3,11 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -16,7 +19,10 @@ import (
 	"github.com/breml/go-uptime-kuma-client/dockerhost"
 )
 
-var _ resource.Resource = &DockerHostResource{}
+var (
+	_ resource.Resource                = &DockerHostResource{}
+	_ resource.ResourceWithImportState = &DockerHostResource{}
+)
 
 // NewDockerHostResource returns a new instance of the docker host resource.
 func NewDockerHostResource() resource.Resource {
@@ -192,3 +198,23 @@ func (r *DockerHostResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
+
+// ImportState imports an existing resource by ID.
+func (*DockerHostResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be a valid integer, got: %s", req.ID),
+		)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -65,6 +65,11 @@ func TestAccDockerHostResource(t *testing.T) {
 					),
 				},
 			},
+			{
+				ResourceName:      "uptimekuma_docker_host.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -27,10 +27,15 @@ import (
 	"github.com/breml/go-uptime-kuma-client/maintenance"
 )
 
+// maintenancePredictionMargin is the time between plan and apply, for which
+// the timeslot_list predicted at plan time must not change to be planned.
+const maintenancePredictionMargin = time.Hour
+
 var (
 	// Ensure MaintenanceResource satisfies various resource interfaces.
 	_ resource.Resource                = &MaintenanceResource{}
 	_ resource.ResourceWithImportState = &MaintenanceResource{}
+	_ resource.ResourceWithModifyPlan  = &MaintenanceResource{}
 )
 
 // NewMaintenanceResource returns a new instance of the Maintenance resource.
@@ -226,8 +231,10 @@ func (*MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 			},
 			"timeslot_list": schema.ListNestedAttribute{
-				MarkdownDescription: "Scheduled maintenance windows",
-				Computed:            true,
+				MarkdownDescription: "Scheduled maintenance windows. For recurring and cron strategies with a " +
+					"timezone other than `SAME_AS_SERVER`, the window in progress and the next window are " +
+					"predicted at plan time, unless a window starts or ends within the next hour",
+				Computed: true,
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
@@ -393,6 +400,198 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// ModifyPlan predicts the timeslot_list of a created or changed maintenance,
+// which is otherwise only known after the apply. The timeslot_list stays
+// unknown, if the prediction can not be trusted.
+func (*MaintenanceResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to plan on destroy or without changes.
+	if req.Plan.Raw.IsNull() || (!req.State.Raw.IsNull() && req.Plan.Raw.Equal(req.State.Raw)) {
+		return
+	}
+
+	var data MaintenanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeslotList := predictMaintenanceTimeslotList(ctx, &data, time.Now())
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("timeslot_list"), timeslotList)...)
+}
+
+// predictMaintenanceTimeslotList returns the timeslot_list Uptime Kuma reports
+// for the maintenance at now, the window in progress and the next window. As
+// the timeslot_list of the apply must match the plan, it returns an unknown
+// list, if the windows can not be predicted or if they change within
+// maintenancePredictionMargin, e.g. because a window starts or ends.
+func predictMaintenanceTimeslotList(
+	ctx context.Context,
+	data *MaintenanceResourceModel,
+	now time.Time,
+) types.List {
+	list := predictMaintenanceTimeslotListAt(ctx, data, now)
+	if !list.Equal(predictMaintenanceTimeslotListAt(ctx, data, now.Add(maintenancePredictionMargin))) {
+		return types.ListUnknown(types.ObjectType{AttrTypes: maintenanceWindowAttrTypes()})
+	}
+
+	return list
+}
+
+// predictMaintenanceTimeslotListAt returns the timeslot_list Uptime Kuma
+// reports for the maintenance at now. It returns an unknown list, if the
+// windows can not be predicted.
+func predictMaintenanceTimeslotListAt(
+	ctx context.Context,
+	data *MaintenanceResourceModel,
+	now time.Time,
+) types.List {
+	unknown := types.ListUnknown(types.ObjectType{AttrTypes: maintenanceWindowAttrTypes()})
+
+	if !isKnown(data.Active) || !isKnown(data.Strategy) {
+		return unknown
+	}
+
+	var windows []maintenanceWindow
+
+	switch data.Strategy.ValueString() {
+	case "single":
+		// The dates of single maintenances are reported as stored by the server.
+		return unknown
+
+	case "manual":
+
+	default:
+		// Uptime Kuma only schedules active maintenances.
+		if !data.Active.ValueBool() {
+			break
+		}
+
+		cfg, ok := maintenanceScheduleConfigFromModel(ctx, data)
+		if !ok || !isKnown(data.Timezone) {
+			return unknown
+		}
+
+		loc, err := maintenanceLocation(data.Timezone.ValueString())
+		if err != nil {
+			return unknown
+		}
+
+		windows, err = maintenanceWindows(cfg, loc, now, 2)
+		if err != nil {
+			return unknown
+		}
+
+		if len(windows) > 0 && windows[0].Start.After(now) {
+			windows = windows[:1]
+		}
+	}
+
+	list, diags := maintenanceWindowsToList(windows)
+	if diags.HasError() {
+		return unknown
+	}
+
+	return list
+}
+
+// maintenanceScheduleConfigFromModel returns the schedule of the maintenance.
+// It returns false, if a value of the schedule is not known.
+func maintenanceScheduleConfigFromModel(
+	ctx context.Context,
+	data *MaintenanceResourceModel,
+) (maintenanceScheduleConfig, bool) {
+	for _, v := range []attr.Value{
+		data.StartDate, data.EndDate, data.IntervalDay, data.Weekdays, data.DaysOfMonth,
+		data.DurationMinutes, data.StartTime, data.EndTime,
+	} {
+		if v.IsUnknown() {
+			return maintenanceScheduleConfig{}, false
+		}
+	}
+
+	// The cron expression of recurring strategies is generated by the server.
+	if data.Strategy.ValueString() == "cron" && !isKnown(data.Cron) {
+		return maintenanceScheduleConfig{}, false
+	}
+
+	cfg := maintenanceScheduleConfig{
+		Strategy:        data.Strategy.ValueString(),
+		StartDate:       data.StartDate.ValueString(),
+		EndDate:         data.EndDate.ValueString(),
+		IntervalDay:     data.IntervalDay.ValueInt64(),
+		Cron:            data.Cron.ValueString(),
+		DurationMinutes: data.DurationMinutes.ValueInt64(),
+	}
+
+	var (
+		weekdays    []types.Int64
+		daysOfMonth []types.String
+		diags       diag.Diagnostics
+	)
+
+	diags.Append(data.Weekdays.ElementsAs(ctx, &weekdays, false)...)
+	diags.Append(data.DaysOfMonth.ElementsAs(ctx, &daysOfMonth, false)...)
+
+	if diags.HasError() {
+		return maintenanceScheduleConfig{}, false
+	}
+
+	for _, weekday := range weekdays {
+		if !isKnown(weekday) {
+			return maintenanceScheduleConfig{}, false
+		}
+
+		cfg.Weekdays = append(cfg.Weekdays, weekday.ValueInt64())
+	}
+
+	for _, day := range daysOfMonth {
+		if !isKnown(day) {
+			return maintenanceScheduleConfig{}, false
+		}
+
+		cfg.DaysOfMonth = append(cfg.DaysOfMonth, day.ValueString())
+	}
+
+	var ok bool
+
+	cfg.StartTime, ok = maintenanceTimeOfDayFromModel(ctx, data.StartTime)
+	if !ok {
+		return maintenanceScheduleConfig{}, false
+	}
+
+	cfg.EndTime, ok = maintenanceTimeOfDayFromModel(ctx, data.EndTime)
+	if !ok {
+		return maintenanceScheduleConfig{}, false
+	}
+
+	return cfg, true
+}
+
+// maintenanceTimeOfDayFromModel returns the time of day of start_time or
+// end_time, nil if it is null. It returns false, if a value is not known.
+func maintenanceTimeOfDayFromModel(ctx context.Context, obj types.Object) (*maintenanceTimeOfDay, bool) {
+	if obj.IsNull() {
+		return nil, true
+	}
+
+	var timeOfDay TimeOfDayModel
+
+	diags := obj.As(ctx, &timeOfDay, basetypes.ObjectAsOptions{})
+	if diags.HasError() || !isKnown(timeOfDay.Hours) || !isKnown(timeOfDay.Minutes) {
+		return nil, false
+	}
+
+	return &maintenanceTimeOfDay{
+		Hours:   timeOfDay.Hours.ValueInt64(),
+		Minutes: timeOfDay.Minutes.ValueInt64(),
+	}, true
+}
+
 // ValidateConfig validates the resource configuration.
 func (*MaintenanceResource) ValidateConfig(
 	ctx context.Context,
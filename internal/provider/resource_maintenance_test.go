@@ -2,13 +2,22 @@ package provider
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
 )
 
 func TestAccMaintenanceResource_Single(t *testing.T) {
@@ -496,3 +505,165 @@ resource "uptimekuma_maintenance" "test" {
 }
 `, title)
 }
+
+func TestPredictMaintenanceTimeslotList(t *testing.T) {
+	timeOfDay := func(hours int64) types.Object {
+		return types.ObjectValueMust(
+			map[string]attr.Type{"hours": types.Int64Type, "minutes": types.Int64Type, "seconds": types.Int64Type},
+			map[string]attr.Value{
+				"hours":   types.Int64Value(hours),
+				"minutes": types.Int64Value(0),
+				"seconds": types.Int64Value(0),
+			},
+		)
+	}
+
+	weekday := MaintenanceResourceModel{
+		Strategy:        types.StringValue("recurring-weekday"),
+		Active:          types.BoolValue(true),
+		StartDate:       types.StringNull(),
+		EndDate:         types.StringNull(),
+		IntervalDay:     types.Int64Null(),
+		Weekdays:        types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)}),
+		DaysOfMonth:     types.ListNull(types.StringType),
+		Cron:            types.StringUnknown(),
+		DurationMinutes: types.Int64Null(),
+		StartTime:       timeOfDay(9),
+		EndTime:         timeOfDay(11),
+		Timezone:        types.StringValue("UTC"),
+	}
+
+	inactive := weekday
+	inactive.Active = types.BoolValue(false)
+
+	sameAsServer := weekday
+	sameAsServer.Timezone = types.StringValue("SAME_AS_SERVER")
+
+	unknownWeekdays := weekday
+	unknownWeekdays.Weekdays = types.ListUnknown(types.Int64Type)
+
+	tests := []struct {
+		name        string
+		data        MaintenanceResourceModel
+		now         time.Time
+		wantUnknown bool
+		want        []string
+	}{
+		{
+			name: "next window",
+			data: weekday,
+			now:  time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC),
+			want: []string{"2026-10-26T09:00:00Z"},
+		},
+		{
+			name: "window in progress",
+			data: weekday,
+			now:  time.Date(2026, time.October, 19, 9, 30, 0, 0, time.UTC),
+			want: []string{"2026-10-19T09:00:00Z", "2026-10-26T09:00:00Z"},
+		},
+		{
+			name:        "window ends before apply",
+			data:        weekday,
+			now:         time.Date(2026, time.October, 19, 10, 30, 0, 0, time.UTC),
+			wantUnknown: true,
+		},
+		{name: "inactive", data: inactive, now: time.Now()},
+		{name: "same as server", data: sameAsServer, now: time.Now(), wantUnknown: true},
+		{name: "unknown weekdays", data: unknownWeekdays, now: time.Now(), wantUnknown: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := predictMaintenanceTimeslotList(t.Context(), &tt.data, tt.now)
+			if list.IsUnknown() != tt.wantUnknown {
+				t.Fatalf("unknown = %t, want %t", list.IsUnknown(), tt.wantUnknown)
+			}
+
+			if tt.wantUnknown {
+				return
+			}
+
+			var timeslots []TimeslotModel
+
+			diags := list.ElementsAs(t.Context(), &timeslots, false)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			got := make([]string, len(timeslots))
+			for i, ts := range timeslots {
+				got[i] = ts.StartDate.ValueString()
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("start dates = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccMaintenanceResource_PredictedTimeslotList(t *testing.T) {
+	title := acctest.RandomWithPrefix("TestMaintenancePredicted")
+	kumaClient := testAccOutOfBandClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaintenanceResourceConfigRecurringWeekday(title),
+				Check:  testAccCheckMaintenanceTimeslotListPredicted(t, kumaClient, "uptimekuma_maintenance.test"),
+			},
+			{
+				Config: testAccMaintenanceResourceConfigCron(title, "0 3 * * 0", 90),
+				Check:  testAccCheckMaintenanceTimeslotListPredicted(t, kumaClient, "uptimekuma_maintenance.test"),
+			},
+		},
+	})
+}
+
+// testAccCheckMaintenanceTimeslotListPredicted checks, that the timeslot_list
+// predicted for the maintenance matches the timeslot_list reported by Uptime
+// Kuma, unless the prediction is unknown.
+func testAccCheckMaintenanceTimeslotListPredicted(
+	t *testing.T,
+	kumaClient *kuma.Client,
+	resourceAddr string,
+) resource.TestCheckFunc {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceAddr]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceAddr)
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.Attributes["id"], 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse ID of %s: %w", resourceAddr, err)
+		}
+
+		m, err := kumaClient.GetMaintenance(t.Context(), id)
+		if err != nil {
+			return fmt.Errorf("read maintenance %d: %w", id, err)
+		}
+
+		var (
+			r     MaintenanceResource
+			data  MaintenanceResourceModel
+			diags diag.Diagnostics
+		)
+
+		r.populateModelFromMaintenance(t.Context(), m, &data, &diags)
+		if diags.HasError() {
+			return fmt.Errorf("populate maintenance %d: %v", id, diags)
+		}
+
+		predicted := predictMaintenanceTimeslotList(t.Context(), &data, time.Now())
+		if !predicted.IsUnknown() && !predicted.Equal(data.TimeslotList) {
+			return fmt.Errorf("predicted timeslot_list %s, Uptime Kuma reports %s", predicted, data.TimeslotList)
+		}
+
+		return nil
+	}
+}
@@ -3,10 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -23,9 +27,26 @@ import (
 // Tags are used to organize and categorize monitors in Uptime Kuma.
 type MonitorTagModel struct {
 	TagID types.Int64  `tfsdk:"tag_id"` // Unique identifier of the tag.
+	Name  types.String `tfsdk:"name"`   // Name of the tag, alternative to the tag ID.
 	Value types.String `tfsdk:"value"`  // Display value or name of the tag.
 }
 
+// monitorTagOptions configures the handling of the tags of monitors.
+type monitorTagOptions struct {
+	autoCreate bool        // Create tags referenced by name, which do not exist.
+	color      string      // Color of tags created automatically.
+	createMu   *sync.Mutex // Serializes the creation of tags, if set.
+}
+
+// monitorTagAttrTypes returns the attribute types of an element of `tags`.
+func monitorTagAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"tag_id": types.Int64Type,
+		"name":   types.StringType,
+		"value":  types.StringType,
+	}
+}
+
 // MonitorBaseModel describes the base data model for all monitor types.
 // All monitor types inherit these common attributes for management and configuration.
 type MonitorBaseModel struct {
@@ -111,18 +132,42 @@ func withMonitorBaseAttributes(attrs map[string]schema.Attribute) map[string]sch
 		Default:             booldefault.StaticBool(true),
 	}
 	attrs["notification_ids"] = schema.ListAttribute{
-		MarkdownDescription: "List of notification IDs",
-		ElementType:         types.Int64Type,
-		Optional:            true,
+		MarkdownDescription: "List of notification IDs. If not set, the notifications of the monitor are not " +
+			"managed by this resource, e.g. to attach them with `uptimekuma_monitor_notification`",
+		ElementType: types.Int64Type,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			useStateForUnconfigured{},
+		},
 	}
 	attrs["tags"] = schema.SetNestedAttribute{
-		MarkdownDescription: "Set of tags assigned to this monitor",
-		Optional:            true,
+		MarkdownDescription: "Set of tags assigned to this monitor. If not set, the tags of the monitor are not " +
+			"managed by this resource, e.g. to attach them with `uptimekuma_monitor_tag`",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Set{
+			useStateForUnconfigured{},
+			useStateForResolvedMonitorTags{},
+		},
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
 				"tag_id": schema.Int64Attribute{
-					MarkdownDescription: "Tag ID",
-					Required:            true,
+					MarkdownDescription: "Tag ID. Exactly one of `tag_id` and `name` must be set",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("name")),
+					},
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Tag name, resolved to the tag ID at apply time. Missing tags are created, " +
+						"if `auto_create_tags` is enabled in the provider configuration",
+					Optional: true,
+					Computed: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
 				},
 				"value": schema.StringAttribute{
 					MarkdownDescription: "Optional value for this tag",
@@ -216,14 +261,20 @@ func handleMonitorActiveStateUpdate(
 func handleMonitorTagsCreate(
 	ctx context.Context,
 	client *kuma.Client,
+	options monitorTagOptions,
 	monitorID int64,
-	tags types.Set,
+	tags *types.Set,
 	diags *diag.Diagnostics,
 ) {
 	if tags.IsNull() || tags.IsUnknown() {
 		return
 	}
 
+	resolveMonitorTags(ctx, client, options, tags, diags)
+	if diags.HasError() {
+		return
+	}
+
 	var monitorTags []MonitorTagModel
 	diags.Append(tags.ElementsAs(ctx, &monitorTags, false)...)
 	if diags.HasError() {
@@ -256,12 +307,7 @@ func handleMonitorTagsRead(
 	stateTags types.Set,
 	diags *diag.Diagnostics,
 ) types.Set {
-	tagObjType := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"tag_id": types.Int64Type,
-			"value":  types.StringType,
-		},
-	}
+	tagObjType := types.ObjectType{AttrTypes: monitorTagAttrTypes()}
 
 	// When the API returns no tags, preserve only null/unknown semantics.
 	// If state already has a known value, return an explicit empty set so
@@ -289,6 +335,7 @@ func handleMonitorTagsRead(
 
 		tagModels[i] = MonitorTagModel{
 			TagID: types.Int64Value(monitorTag.TagID),
+			Name:  types.StringValue(monitorTag.Name),
 			Value: value,
 		}
 	}
@@ -302,17 +349,23 @@ func handleMonitorTagsRead(
 func handleMonitorTagsUpdate(
 	ctx context.Context,
 	client *kuma.Client,
+	options monitorTagOptions,
 	monitorID int64,
 	oldTags types.Set,
-	newTags types.Set,
+	newTags *types.Set,
 	diags *diag.Diagnostics,
 ) {
+	resolveMonitorTags(ctx, client, options, newTags, diags)
+	if diags.HasError() {
+		return
+	}
+
 	oldMonitorTags := deserializeMonitorTags(ctx, oldTags, diags)
 	if diags.HasError() {
 		return
 	}
 
-	newMonitorTags := deserializeMonitorTags(ctx, newTags, diags)
+	newMonitorTags := deserializeMonitorTags(ctx, *newTags, diags)
 	if diags.HasError() {
 		return
 	}
@@ -328,6 +381,128 @@ func handleMonitorTagsUpdate(
 	handleAddedMonitorTags(ctx, client, monitorID, oldTagMap, newTagMap, diags)
 }
 
+// resolveMonitorTags completes the tags by the tag ID or the name, whichever
+// is not known yet. Tags referenced by name, which do not exist, are created
+// if enabled in the options.
+func resolveMonitorTags(
+	ctx context.Context,
+	client *kuma.Client,
+	options monitorTagOptions,
+	tags *types.Set,
+	diags *diag.Diagnostics,
+) {
+	monitorTags := deserializeMonitorTags(ctx, *tags, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if !slices.ContainsFunc(monitorTags, func(t MonitorTagModel) bool {
+		return !isKnown(t.TagID) || !isKnown(t.Name)
+	}) {
+		return
+	}
+
+	tagList, err := client.GetTags(ctx)
+	// Handle error.
+	if err != nil {
+		diags.AddError("failed to read tags", err.Error())
+		return
+	}
+
+	for i, monitorTag := range monitorTags {
+		switch {
+		case isKnown(monitorTag.TagID) && !isKnown(monitorTag.Name):
+			found, ok := findTag(tagList, monitorTag.TagID, monitorTag.Name, diags)
+			if !ok {
+				diags.AddError("Unknown Tag", fmt.Sprintf("Tag with ID %d does not exist.", monitorTag.TagID.ValueInt64()))
+				return
+			}
+
+			monitorTags[i].Name = types.StringValue(found.Name)
+
+		case !isKnown(monitorTag.TagID) && isKnown(monitorTag.Name):
+			name := monitorTag.Name.ValueString()
+
+			found, ok := findTag(tagList, monitorTag.TagID, monitorTag.Name, diags)
+
+			switch {
+			case diags.HasError():
+				return
+
+			case !ok && !options.autoCreate:
+				diags.AddError(
+					"Unknown Tag",
+					fmt.Sprintf(
+						"Tag with name %q does not exist. Create it or enable `auto_create_tags` in the provider "+
+							"configuration.",
+						name,
+					),
+				)
+				return
+
+			case !ok:
+				found, err = getOrCreateMonitorTag(ctx, client, options, name)
+				// Handle error.
+				if err != nil {
+					diags.AddError(fmt.Sprintf("failed to create tag %q", name), err.Error())
+					return
+				}
+
+				tagList = append(tagList, found)
+			}
+
+			monitorTags[i].TagID = types.Int64Value(found.ID)
+		}
+	}
+
+	resolved, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: monitorTagAttrTypes()}, monitorTags)
+	diags.Append(d...)
+
+	// Populate state.
+	*tags = resolved
+}
+
+// getOrCreateMonitorTag creates the tag with the given name. The tags are read
+// again while holding the lock, so a tag created in the meantime by another
+// monitor is returned instead of creating it twice.
+func getOrCreateMonitorTag(
+	ctx context.Context,
+	client *kuma.Client,
+	options monitorTagOptions,
+	name string,
+) (tag.Tag, error) {
+	if options.createMu != nil {
+		options.createMu.Lock()
+		defer options.createMu.Unlock()
+	}
+
+	tagList, err := client.GetTags(ctx)
+	// Handle error.
+	if err != nil {
+		return tag.Tag{}, fmt.Errorf("read tags: %w", err)
+	}
+
+	idx := slices.IndexFunc(tagList, func(t tag.Tag) bool { return t.Name == name })
+	if idx >= 0 {
+		return tagList[idx], nil
+	}
+
+	newTag := tag.Tag{Name: name, Color: options.color}
+
+	newTag.ID, err = client.CreateTag(ctx, newTag)
+	// Handle error.
+	if err != nil {
+		return tag.Tag{}, err
+	}
+
+	return newTag, nil
+}
+
+// isKnown reports, whether the value is neither null nor unknown.
+func isKnown(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
 func deserializeMonitorTags(ctx context.Context, tags types.Set, diags *diag.Diagnostics) []MonitorTagModel {
 	if tags.IsNull() || tags.IsUnknown() {
 		return []MonitorTagModel{}
@@ -406,3 +581,113 @@ func handleAddedMonitorTags(
 		}
 	}
 }
+
+// useStateForUnconfigured is a plan modifier, which keeps the value of an
+// optional and computed list or set from the prior state, if it is not
+// configured. On create, the value is planned as null.
+type useStateForUnconfigured struct{}
+
+// Description returns a plain text description of the plan modifier's behavior.
+func (m useStateForUnconfigured) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the plan modifier's behavior.
+func (useStateForUnconfigured) MarkdownDescription(_ context.Context) string {
+	return "If not configured, the value is kept from the prior state."
+}
+
+// PlanModifyList sets the planned value to the prior state, if the value is not configured.
+func (useStateForUnconfigured) PlanModifyList(
+	ctx context.Context,
+	req planmodifier.ListRequest,
+	resp *planmodifier.ListResponse,
+) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.StateValue.IsNull() {
+		resp.PlanValue = types.ListNull(req.ConfigValue.ElementType(ctx))
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// PlanModifySet sets the planned value to the prior state, if the value is not configured.
+func (useStateForUnconfigured) PlanModifySet(
+	ctx context.Context,
+	req planmodifier.SetRequest,
+	resp *planmodifier.SetResponse,
+) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	if req.StateValue.IsNull() {
+		resp.PlanValue = types.SetNull(req.ConfigValue.ElementType(ctx))
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// useStateForResolvedMonitorTags is a plan modifier, which completes the tag
+// ID or the name of the planned tags from the matching tags in the prior
+// state. Without it, a tag referenced only by name or ID would always differ
+// from the resolved tag in the state.
+type useStateForResolvedMonitorTags struct{}
+
+// Description returns a plain text description of the plan modifier's behavior.
+func (m useStateForResolvedMonitorTags) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the plan modifier's behavior.
+func (useStateForResolvedMonitorTags) MarkdownDescription(_ context.Context) string {
+	return "Once resolved, the tag ID and the name of a tag are kept from the prior state."
+}
+
+// PlanModifySet completes the unknown tag IDs and names from the prior state.
+func (useStateForResolvedMonitorTags) PlanModifySet(
+	ctx context.Context,
+	req planmodifier.SetRequest,
+	resp *planmodifier.SetResponse,
+) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planTags, stateTags []MonitorTagModel
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &planTags, false)...)
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &stateTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, planTag := range planTags {
+		idx := slices.IndexFunc(stateTags, func(stateTag MonitorTagModel) bool {
+			if planTag.Value.ValueString() != stateTag.Value.ValueString() {
+				return false
+			}
+
+			if isKnown(planTag.TagID) {
+				return planTag.TagID.Equal(stateTag.TagID)
+			}
+
+			return isKnown(planTag.Name) && planTag.Name.Equal(stateTag.Name)
+		})
+		if idx < 0 {
+			continue
+		}
+
+		planTags[i].TagID = stateTags[idx].TagID
+		planTags[i].Name = stateTags[idx].Name
+	}
+
+	planValue, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: monitorTagAttrTypes()}, planTags)
+	resp.Diagnostics.Append(diags...)
+
+	resp.PlanValue = planValue
+}
@@ -22,6 +22,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorDNSResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorDNSResource{}
 	_ resource.ResourceWithImportState = &MonitorDNSResource{}
 )
 
@@ -32,7 +33,8 @@ func NewMonitorDNSResource() resource.Resource {
 
 // MonitorDNSResource defines the resource implementation.
 type MonitorDNSResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorDNSResourceModel describes the resource data model.
@@ -96,6 +98,15 @@ func (*MonitorDNSResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorDNSResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorDNSResource) Configure(
 	_ context.Context,
@@ -103,6 +114,7 @@ func (r *MonitorDNSResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -168,7 +180,7 @@ func (r *MonitorDNSResource) Create(ctx context.Context, req resource.CreateRequ
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -338,7 +350,15 @@ func (r *MonitorDNSResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -19,6 +19,7 @@ import (
 var (
 	// Ensure MonitorDockerResource satisfies various resource interfaces.
 	_ resource.Resource                = &MonitorDockerResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorDockerResource{}
 	_ resource.ResourceWithImportState = &MonitorDockerResource{}
 )
 
@@ -29,7 +30,8 @@ func NewMonitorDockerResource() resource.Resource {
 
 // MonitorDockerResource defines the resource implementation.
 type MonitorDockerResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorDockerResourceModel describes the resource data model for Docker monitors.
@@ -57,6 +59,15 @@ func (*MonitorDockerResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorDockerResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorDockerResource) Configure(
 	_ context.Context,
@@ -64,6 +75,7 @@ func (r *MonitorDockerResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -86,7 +98,7 @@ func (r *MonitorDockerResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	data.ID = types.Int64Value(id)
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -255,7 +267,15 @@ func (r *MonitorDockerResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -22,6 +22,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorGameDigResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorGameDigResource{}
 	_ resource.ResourceWithImportState = &MonitorGameDigResource{}
 )
 
@@ -32,7 +33,8 @@ func NewMonitorGameDigResource() resource.Resource {
 
 // MonitorGameDigResource defines the resource implementation for GameDig game server monitors.
 type MonitorGameDigResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorGameDigResourceModel describes the resource data model for GameDig monitors.
@@ -101,6 +103,15 @@ func (*MonitorGameDigResource) Schema(
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorGameDigResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the GameDig monitor resource with the API client.
 func (r *MonitorGameDigResource) Configure(
 	_ context.Context,
@@ -108,6 +119,7 @@ func (r *MonitorGameDigResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new GameDig monitor resource.
@@ -137,7 +149,7 @@ func (r *MonitorGameDigResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -226,7 +238,15 @@ func (r *MonitorGameDigResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
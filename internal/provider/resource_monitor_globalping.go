@@ -23,8 +23,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &MonitorGlobalpingResource{}
-	_ resource.ResourceWithImportState = &MonitorGlobalpingResource{}
+	_ resource.Resource                     = &MonitorGlobalpingResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorGlobalpingResource{}
+	_ resource.ResourceWithImportState      = &MonitorGlobalpingResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorGlobalpingResource{}
 )
 
 // NewMonitorGlobalpingResource returns a new instance of the Globalping monitor resource.
@@ -34,7 +36,8 @@ func NewMonitorGlobalpingResource() resource.Resource {
 
 // MonitorGlobalpingResource defines the resource implementation for Globalping monitors.
 type MonitorGlobalpingResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorGlobalpingResourceModel describes the resource data model for Globalping monitors.
@@ -170,6 +173,20 @@ func (*MonitorGlobalpingResource) Schema(
 	}
 }
 
+// ConfigValidators returns the config validators for the resource.
+func (*MonitorGlobalpingResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return httpMonitorConfigValidators()
+}
+
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorGlobalpingResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the Globalping monitor resource with the API client.
 func (r *MonitorGlobalpingResource) Configure(
 	_ context.Context,
@@ -177,6 +194,7 @@ func (r *MonitorGlobalpingResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new Globalping monitor resource.
@@ -205,7 +223,7 @@ func (r *MonitorGlobalpingResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -297,7 +315,15 @@ func (r *MonitorGlobalpingResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -374,7 +400,7 @@ func buildGlobalpingMonitor(
 			AcceptedStatusCodes: []string{},
 			HTTPBodyEncoding:    data.HTTPBodyEncoding.ValueString(),
 			Body:                data.Body.ValueString(),
-			Headers:             data.Headers.ValueString(),
+			Headers:             buildHTTPHeaders(ctx, &data.MonitorHTTPBaseModel, diags),
 			AuthMethod:          monitor.AuthMethod(data.AuthMethod.ValueString()),
 			BasicAuthUser:       data.BasicAuthUser.ValueString(),
 			BasicAuthPass:       data.BasicAuthPass.ValueString(),
@@ -468,8 +494,8 @@ func populateGlobalpingModel(globalpingMonitor *monitor.Globalping, data *Monito
 	data.IgnoreTLS = types.BoolValue(globalpingMonitor.IgnoreTLS)
 	data.MaxRedirects = types.Int64Value(int64(globalpingMonitor.MaxRedirects))
 	data.HTTPBodyEncoding = types.StringValue(globalpingMonitor.HTTPBodyEncoding)
-	data.Body = stringOrNull(globalpingMonitor.Body)
-	data.Headers = stringOrNull(globalpingMonitor.Headers)
+	data.Body = normalizedJSONOrNull(globalpingMonitor.Body)
+	populateHTTPHeaders(globalpingMonitor.Headers, &data.MonitorHTTPBaseModel)
 	data.AuthMethod = types.StringValue(string(globalpingMonitor.AuthMethod))
 	data.BasicAuthUser = stringOrNull(globalpingMonitor.BasicAuthUser)
 	data.BasicAuthPass = stringOrNull(globalpingMonitor.BasicAuthPass)
@@ -17,6 +17,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorGroupResource{}
 	_ resource.ResourceWithImportState = &MonitorGroupResource{}
 )
 
@@ -27,7 +28,8 @@ func NewMonitorGroupResource() resource.Resource {
 
 // MonitorGroupResource defines the resource implementation.
 type MonitorGroupResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorGroupResourceModel describes the resource data model.
@@ -52,6 +54,15 @@ func (*MonitorGroupResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorGroupResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorGroupResource) Configure(
 	_ context.Context,
@@ -59,6 +70,7 @@ func (r *MonitorGroupResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -112,7 +124,7 @@ func (r *MonitorGroupResource) Create(ctx context.Context, req resource.CreateRe
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -260,7 +272,15 @@ func (r *MonitorGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
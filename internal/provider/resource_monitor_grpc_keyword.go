@@ -21,6 +21,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorGrpcKeywordResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorGrpcKeywordResource{}
 	_ resource.ResourceWithImportState = &MonitorGrpcKeywordResource{}
 )
 
@@ -31,22 +32,23 @@ func NewMonitorGrpcKeywordResource() resource.Resource {
 
 // MonitorGrpcKeywordResource defines the resource implementation.
 type MonitorGrpcKeywordResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorGrpcKeywordResourceModel describes the resource data model.
 type MonitorGrpcKeywordResourceModel struct {
 	MonitorBaseModel
 
-	GrpcURL                  types.String `tfsdk:"grpc_url"`
-	GrpcProtobuf             types.String `tfsdk:"grpc_protobuf"`
-	GrpcServiceName          types.String `tfsdk:"grpc_service_name"`
-	GrpcMethod               types.String `tfsdk:"grpc_method"`
-	GrpcEnableTLS            types.Bool   `tfsdk:"grpc_enable_tls"`
-	GrpcBody                 types.String `tfsdk:"grpc_body"`
-	Keyword                  types.String `tfsdk:"keyword"`
-	InvertKeyword            types.Bool   `tfsdk:"invert_keyword"`
-	DomainExpiryNotification types.Bool   `tfsdk:"domain_expiry_notification"`
+	GrpcURL                  types.String        `tfsdk:"grpc_url"`
+	GrpcProtobuf             types.String        `tfsdk:"grpc_protobuf"`
+	GrpcServiceName          types.String        `tfsdk:"grpc_service_name"`
+	GrpcMethod               types.String        `tfsdk:"grpc_method"`
+	GrpcEnableTLS            types.Bool          `tfsdk:"grpc_enable_tls"`
+	GrpcBody                 NormalizedJSONValue `tfsdk:"grpc_body"`
+	Keyword                  types.String        `tfsdk:"keyword"`
+	InvertKeyword            types.Bool          `tfsdk:"invert_keyword"`
+	DomainExpiryNotification types.Bool          `tfsdk:"domain_expiry_notification"`
 }
 
 // Metadata returns the metadata for the resource.
@@ -103,8 +105,12 @@ func (*MonitorGrpcKeywordResource) Schema(
 			},
 			"grpc_body": schema.StringAttribute{
 				MarkdownDescription: "Request body in JSON format",
+				CustomType:          NormalizedJSONType{},
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					validateJSON(),
+				},
 			},
 			"keyword": schema.StringAttribute{
 				MarkdownDescription: "Keyword to search for in the response body (case-sensitive). The monitor will search for this exact text in the gRPC response.",
@@ -124,6 +130,15 @@ func (*MonitorGrpcKeywordResource) Schema(
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorGrpcKeywordResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorGrpcKeywordResource) Configure(
 	_ context.Context,
@@ -131,6 +146,7 @@ func (r *MonitorGrpcKeywordResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -202,7 +218,7 @@ func (r *MonitorGrpcKeywordResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -350,7 +366,15 @@ func (r *MonitorGrpcKeywordResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -447,7 +471,7 @@ func (*MonitorGrpcKeywordResource) populateModelFromMonitor(
 	data.GrpcServiceName = types.StringValue(grpcKeywordMonitor.GrpcServiceName)
 	data.GrpcMethod = types.StringValue(grpcKeywordMonitor.GrpcMethod)
 	data.GrpcEnableTLS = types.BoolValue(grpcKeywordMonitor.GrpcEnableTLS)
-	data.GrpcBody = stringOrNull(grpcKeywordMonitor.GrpcBody)
+	data.GrpcBody = normalizedJSONOrNull(grpcKeywordMonitor.GrpcBody)
 	data.Keyword = types.StringValue(grpcKeywordMonitor.Keyword)
 	data.InvertKeyword = types.BoolValue(grpcKeywordMonitor.InvertKeyword)
 	data.DomainExpiryNotification = types.BoolValue(grpcKeywordMonitor.DomainExpiryNotification)
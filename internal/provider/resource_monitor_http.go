@@ -18,8 +18,11 @@ import (
 
 var (
 	// Ensure MonitorHTTPResource satisfies various resource interfaces.
-	_ resource.Resource                = &MonitorHTTPResource{}
-	_ resource.ResourceWithImportState = &MonitorHTTPResource{}
+	_ resource.Resource                     = &MonitorHTTPResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorHTTPResource{}
+	_ resource.ResourceWithImportState      = &MonitorHTTPResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorHTTPResource{}
+	_ resource.ResourceWithMoveState        = &MonitorHTTPResource{}
 )
 
 // NewMonitorHTTPResource returns a new instance of the HTTP monitor resource.
@@ -29,7 +32,8 @@ func NewMonitorHTTPResource() resource.Resource {
 
 // MonitorHTTPResource defines the resource implementation.
 type MonitorHTTPResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorHTTPResourceModel describes the resource data model for HTTP monitors.
@@ -60,6 +64,22 @@ func (*MonitorHTTPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ConfigValidators returns the config validators for the resource.
+func (*MonitorHTTPResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return httpMonitorConfigValidators()
+}
+
+// ModifyPlan validates the references to other objects at plan time and
+// forces the update of a moved monitor, whose type has not been changed yet.
+func (r *MonitorHTTPResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+	planMonitorTypeChange(ctx, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorHTTPResource) Configure(
 	_ context.Context,
@@ -67,6 +87,7 @@ func (r *MonitorHTTPResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -89,7 +110,7 @@ func (r *MonitorHTTPResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	data.ID = types.Int64Value(id)
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -126,7 +147,7 @@ func buildHTTPMonitor(ctx context.Context, data *MonitorHTTPResourceModel, diags
 			AcceptedStatusCodes:      []string{},
 			HTTPBodyEncoding:         data.HTTPBodyEncoding.ValueString(),
 			Body:                     data.Body.ValueString(),
-			Headers:                  data.Headers.ValueString(),
+			Headers:                  buildHTTPHeaders(ctx, &data.MonitorHTTPBaseModel, diags),
 			AuthMethod:               monitor.AuthMethod(data.AuthMethod.ValueString()),
 			BasicAuthUser:            data.BasicAuthUser.ValueString(),
 			BasicAuthPass:            data.BasicAuthPass.ValueString(),
@@ -228,8 +249,8 @@ func populateHTTPMonitorBaseFieldsForHTTP(httpMonitor *monitor.HTTP, m *MonitorH
 	m.IgnoreTLS = types.BoolValue(httpMonitor.IgnoreTLS)
 	m.MaxRedirects = types.Int64Value(int64(httpMonitor.MaxRedirects))
 	m.HTTPBodyEncoding = types.StringValue(httpMonitor.HTTPBodyEncoding)
-	m.Body = stringOrNull(httpMonitor.Body)
-	m.Headers = stringOrNull(httpMonitor.Headers)
+	m.Body = normalizedJSONOrNull(httpMonitor.Body)
+	populateHTTPHeaders(httpMonitor.Headers, &m.MonitorHTTPBaseModel)
 	m.AuthMethod = types.StringValue(string(httpMonitor.AuthMethod))
 	m.BasicAuthUser = stringOrNull(httpMonitor.BasicAuthUser)
 	m.BasicAuthPass = stringOrNull(httpMonitor.BasicAuthPass)
@@ -308,7 +329,8 @@ func (r *MonitorHTTPResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	if actual := httpMonitor.Base.Type(); actual != "" && actual != httpMonitor.Type() {
+	if actual := httpMonitor.Base.Type(); actual != "" && actual != httpMonitor.Type() &&
+		!isMonitorTypeChangePending(ctx, req.Private) {
 		tflog.Warn(ctx, "monitor type changed externally, removing from state", map[string]any{
 			"id":            data.ID.ValueInt64(),
 			"expected_type": httpMonitor.Type(),
@@ -344,6 +366,9 @@ func (r *MonitorHTTPResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// The ID is unknown in the plan of a moved monitor.
+	data.ID = state.ID
+
 	httpMonitor := buildHTTPMonitor(ctx, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -357,7 +382,15 @@ func (r *MonitorHTTPResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -368,6 +401,18 @@ func (r *MonitorHTTPResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// The type of a moved monitor is changed by this update.
+	if isMonitorTypeChangePending(ctx, req.Private) {
+		completeMonitorTypeChange(
+			ctx,
+			r.client,
+			data.ID.ValueInt64(),
+			httpMonitor.Type(),
+			resp.Private,
+			&resp.Diagnostics,
+		)
+	}
 }
 
 // Delete deletes the resource.
@@ -410,3 +455,8 @@ func (*MonitorHTTPResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of the other HTTP-based monitors into this resource.
+func (*MonitorHTTPResource) MoveState(ctx context.Context) []resource.StateMover {
+	return moveMonitorState(ctx, NewMonitorHTTPKeywordResource, NewMonitorHTTPJSONQueryResource)
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ConfigValidator = httpAuthConfigValidator{}
+	_ validator.String         = pemCertificateValidator{}
+	_ validator.String         = pemPrivateKeyValidator{}
+)
+
+// httpAuthAttributes describes the attributes used by an authentication
+// method of HTTP-based monitors.
+type httpAuthAttributes struct {
+	required []string
+	optional []string
+}
+
+// httpAuthMethodAttributes returns the attributes used by each authentication
+// method. An empty method disables authentication.
+func httpAuthMethodAttributes() map[string]httpAuthAttributes {
+	return map[string]httpAuthAttributes{
+		"": {},
+		"basic": {
+			required: []string{"basic_auth_user", "basic_auth_pass"},
+		},
+		"ntlm": {
+			required: []string{"basic_auth_user", "basic_auth_pass"},
+			optional: []string{"auth_domain", "auth_workstation"},
+		},
+		"oauth2-cc": {
+			required: []string{"oauth_token_url", "oauth_client_id", "oauth_client_secret"},
+			optional: []string{"oauth_scopes", "oauth_audience"},
+		},
+		"mtls": {
+			required: []string{"tls_cert", "tls_key"},
+			optional: []string{"tls_ca"},
+		},
+	}
+}
+
+// httpAuthAttributeNames returns the names of all attributes, which are
+// specific to an authentication method. `oauth_auth_method` is not included,
+// since Uptime Kuma reports a default value for it regardless of the
+// authentication method.
+func httpAuthAttributeNames() []string {
+	return []string{
+		"basic_auth_user",
+		"basic_auth_pass",
+		"auth_domain",
+		"auth_workstation",
+		"oauth_token_url",
+		"oauth_client_id",
+		"oauth_client_secret",
+		"oauth_scopes",
+		"oauth_audience",
+		"tls_cert",
+		"tls_key",
+		"tls_ca",
+	}
+}
+
+// httpMonitorConfigValidators returns the config validators shared by the
+// HTTP-based monitors.
+func httpMonitorConfigValidators() []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		httpAuthConfigValidator{},
+		httpBodyConfigValidator{},
+		httpHeadersConfigValidator{},
+	}
+}
+
+// httpAuthConfigValidator validates, that the attributes required by the
+// selected `auth_method` are set and the attributes of other authentication
+// methods are not. If mTLS is used, it also validates, that the client
+// certificate matches the client key.
+type httpAuthConfigValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v httpAuthConfigValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (httpAuthConfigValidator) MarkdownDescription(_ context.Context) string {
+	return "the authentication attributes must match the selected `auth_method`"
+}
+
+// ValidateResource validates the authentication attributes of the configuration.
+func (httpAuthConfigValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var authMethod types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auth_method"), &authMethod)...)
+	if resp.Diagnostics.HasError() || authMethod.IsUnknown() {
+		return
+	}
+
+	method := authMethod.ValueString()
+
+	attrs, ok := httpAuthMethodAttributes()[method]
+	if !ok {
+		// Unsupported methods are reported by the attribute validator.
+		return
+	}
+
+	values := map[string]types.String{}
+
+	for _, name := range httpAuthAttributeNames() {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		values[name] = value
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, name := range httpAuthAttributeNames() {
+		value := values[name]
+		set := value.IsUnknown() || value.ValueString() != ""
+
+		switch {
+		case slices.Contains(attrs.required, name) && !set:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Authentication Attribute",
+				fmt.Sprintf("The attribute %q is required, when \"auth_method\" is set to %q.", name, method),
+			)
+
+		case !slices.Contains(attrs.required, name) && !slices.Contains(attrs.optional, name) && set:
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Authentication Attribute",
+				fmt.Sprintf(
+					"The attribute %q must not be set, when \"auth_method\" is set to %q. %s",
+					name, method, httpAuthMethodHint(name),
+				),
+			)
+		}
+	}
+
+	if method == "mtls" {
+		validateTLSKeyPair(values["tls_cert"], values["tls_key"], &resp.Diagnostics)
+	}
+}
+
+// httpAuthMethodHint returns a hint, which authentication methods support
+// the given attribute.
+func httpAuthMethodHint(name string) string {
+	var methods []string
+
+	for method, attrs := range httpAuthMethodAttributes() {
+		if slices.Contains(attrs.required, name) || slices.Contains(attrs.optional, name) {
+			methods = append(methods, fmt.Sprintf("%q", method))
+		}
+	}
+
+	slices.Sort(methods)
+
+	return fmt.Sprintf("It is only used with \"auth_method\" %s.", strings.Join(methods, " or "))
+}
+
+// validateTLSKeyPair validates, that the client certificate matches the
+// client key. Invalid PEM input is reported by the attribute validators.
+func validateTLSKeyPair(cert types.String, key types.String, diags *diag.Diagnostics) {
+	if cert.IsUnknown() || key.IsUnknown() || cert.ValueString() == "" || key.ValueString() == "" {
+		return
+	}
+
+	if parsePEMCertificates(cert.ValueString()) != nil || parsePEMPrivateKey(key.ValueString()) != nil {
+		return
+	}
+
+	_, err := tls.X509KeyPair([]byte(cert.ValueString()), []byte(key.ValueString()))
+	// Handle error.
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("tls_key"),
+			"Invalid TLS Key Pair",
+			fmt.Sprintf("The client key does not match the client certificate: %s", err),
+		)
+	}
+}
+
+// parsePEMCertificates parses PEM encoded certificates. At least one
+// certificate is required, other PEM blocks are not allowed.
+func parsePEMCertificates(value string) error {
+	rest := []byte(value)
+	count := 0
+
+	for {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("unexpected PEM block of type %q, expected %q", block.Type, "CERTIFICATE")
+		}
+
+		_, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("certificate %d: %w", count+1, err)
+		}
+
+		count++
+	}
+
+	if count == 0 {
+		return errors.New("no PEM encoded certificate found")
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		return errors.New("unexpected data after the last PEM block")
+	}
+
+	return nil
+}
+
+// parsePEMPrivateKey parses a PEM encoded private key in PKCS #1, PKCS #8
+// or SEC 1 (EC) format.
+func parsePEMPrivateKey(value string) error {
+	block, rest := pem.Decode([]byte(value))
+	if block == nil {
+		return errors.New("no PEM encoded private key found")
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		return errors.New("unexpected data after the private key")
+	}
+
+	var err error
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		_, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		_, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		_, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return fmt.Errorf("unexpected PEM block of type %q, expected a private key", block.Type)
+	}
+
+	if err != nil {
+		return fmt.Errorf("parse private key: %w", err)
+	}
+
+	return nil
+}
+
+// pemCertificateValidator validates, that a string contains PEM encoded
+// certificates.
+type pemCertificateValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (pemCertificateValidator) Description(_ context.Context) string {
+	return "string must contain PEM encoded certificates"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v pemCertificateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the provided string contains PEM encoded certificates.
+func (pemCertificateValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	err := parsePEMCertificates(req.ConfigValue.ValueString())
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PEM Certificate",
+			fmt.Sprintf("Attribute must contain PEM encoded certificates: %s", err),
+		)
+	}
+}
+
+// pemPrivateKeyValidator validates, that a string contains a PEM encoded
+// private key.
+type pemPrivateKeyValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (pemPrivateKeyValidator) Description(_ context.Context) string {
+	return "string must contain a PEM encoded private key"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v pemPrivateKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the provided string contains a PEM encoded private key.
+func (pemPrivateKeyValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	err := parsePEMPrivateKey(req.ConfigValue.ValueString())
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid PEM Private Key",
+			fmt.Sprintf("Attribute must contain a PEM encoded private key: %s", err),
+		)
+	}
+}
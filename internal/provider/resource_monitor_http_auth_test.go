@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"maps"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testPEMKeyPair(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "uptimekuma"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error: %v", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error: %v", err)
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+
+	return string(cert), string(privateKey)
+}
+
+func TestParsePEM(t *testing.T) {
+	cert, key := testPEMKeyPair(t)
+
+	tests := []struct {
+		name    string
+		parse   func(string) error
+		value   string
+		wantErr string
+	}{
+		{name: "certificate", parse: parsePEMCertificates, value: cert},
+		{name: "certificate chain", parse: parsePEMCertificates, value: cert + cert},
+		{name: "certificate garbage", parse: parsePEMCertificates, value: "garbage", wantErr: "no PEM encoded"},
+		{name: "certificate trailing data", parse: parsePEMCertificates, value: cert + "x", wantErr: "unexpected data"},
+		{name: "certificate is key", parse: parsePEMCertificates, value: key, wantErr: "unexpected PEM block"},
+		{name: "key", parse: parsePEMPrivateKey, value: key},
+		{name: "key garbage", parse: parsePEMPrivateKey, value: "garbage", wantErr: "no PEM encoded"},
+		{name: "key is certificate", parse: parsePEMPrivateKey, value: cert, wantErr: "unexpected PEM block"},
+		{
+			name:    "key invalid",
+			parse:   parsePEMPrivateKey,
+			value:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("x")})),
+			wantErr: "parse private key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.parse(tt.value)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHTTPAuthConfigValidator(t *testing.T) {
+	cert, key := testPEMKeyPair(t)
+	_, otherKey := testPEMKeyPair(t)
+
+	tests := []struct {
+		name    string
+		config  map[string]string
+		wantErr string
+	}{
+		{
+			name:   "no authentication",
+			config: map[string]string{"auth_method": ""},
+		},
+		{
+			name:   "no authentication with default oauth method",
+			config: map[string]string{"auth_method": "", "oauth_auth_method": "client_secret_basic"},
+		},
+		{
+			name:    "no authentication with basic auth user",
+			config:  map[string]string{"auth_method": "", "basic_auth_user": "user"},
+			wantErr: "Invalid Authentication Attribute",
+		},
+		{
+			name:   "basic",
+			config: map[string]string{"auth_method": "basic", "basic_auth_user": "user", "basic_auth_pass": "pass"},
+		},
+		{
+			name:   "basic with write-only password",
+			config: map[string]string{"auth_method": "basic", "basic_auth_user": "user", "basic_auth_pass_wo": "pass"},
+		},
+		{
+			name:    "basic without password",
+			config:  map[string]string{"auth_method": "basic", "basic_auth_user": "user"},
+			wantErr: "Missing Authentication Attribute",
+		},
+		{
+			name: "basic with ntlm domain",
+			config: map[string]string{
+				"auth_method": "basic", "basic_auth_user": "user", "basic_auth_pass": "pass", "auth_domain": "corp",
+			},
+			wantErr: "Invalid Authentication Attribute",
+		},
+		{
+			name: "ntlm",
+			config: map[string]string{
+				"auth_method": "ntlm", "basic_auth_user": "user", "basic_auth_pass": "pass", "auth_domain": "corp",
+			},
+		},
+		{
+			name: "oauth2-cc",
+			config: map[string]string{
+				"auth_method":         "oauth2-cc",
+				"oauth_token_url":     "https://auth.example.com/token",
+				"oauth_client_id":     "id",
+				"oauth_client_secret": "secret",
+				"oauth_scopes":        "read",
+			},
+		},
+		{
+			name: "oauth2-cc without client secret",
+			config: map[string]string{
+				"auth_method":     "oauth2-cc",
+				"oauth_token_url": "https://auth.example.com/token",
+				"oauth_client_id": "id",
+			},
+			wantErr: "Missing Authentication Attribute",
+		},
+		{
+			name:   "mtls",
+			config: map[string]string{"auth_method": "mtls", "tls_cert": cert, "tls_key": key, "tls_ca": cert},
+		},
+		{
+			name:    "mtls without key",
+			config:  map[string]string{"auth_method": "mtls", "tls_cert": cert},
+			wantErr: "Missing Authentication Attribute",
+		},
+		{
+			name:    "mtls with mismatching key",
+			config:  map[string]string{"auth_method": "mtls", "tls_cert": cert, "tls_key": otherKey},
+			wantErr: "Invalid TLS Key Pair",
+		},
+		{
+			name:    "mtls with invalid certificate",
+			config:  map[string]string{"auth_method": "mtls", "tls_cert": "garbage", "tls_key": key},
+			wantErr: "Invalid PEM Certificate",
+		},
+		{
+			name:    "tls ca without mtls",
+			config:  map[string]string{"auth_method": "", "tls_ca": cert},
+			wantErr: "Invalid Authentication Attribute",
+		},
+	}
+
+	for _, typeName := range []string{"uptimekuma_monitor_http", "uptimekuma_monitor_http_keyword"} {
+		for _, tt := range tests {
+			t.Run(typeName+"/"+tt.name, func(t *testing.T) {
+				diags := validateResourceConfig(t, typeName, tt.config)
+
+				var summaries []string
+				for _, d := range diags {
+					if d.Severity == tfprotov6.DiagnosticSeverityError {
+						summaries = append(summaries, d.Summary+": "+d.Detail)
+					}
+				}
+
+				if tt.wantErr == "" && len(summaries) > 0 {
+					t.Fatalf("unexpected diagnostics: %v", summaries)
+				}
+
+				if tt.wantErr != "" && !hasErrorDiagnostic(diags, tt.wantErr) {
+					t.Fatalf("expected diagnostic %q, got %v", tt.wantErr, summaries)
+				}
+			})
+		}
+	}
+}
+
+func validateResourceConfig(t *testing.T, typeName string, config map[string]string) []*tfprotov6.Diagnostic {
+	t.Helper()
+
+	values := make(map[string]tftypes.Value, len(config))
+	for name, value := range config {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	if _, ok := config["basic_auth_pass_wo"]; ok {
+		values["basic_auth_pass_wo_version"] = tftypes.NewValue(tftypes.Number, 1)
+	}
+
+	return validateResourceConfigValues(t, typeName, values)
+}
+
+func validateResourceConfigValues(
+	t *testing.T,
+	typeName string,
+	config map[string]tftypes.Value,
+) []*tfprotov6.Diagnostic {
+	t.Helper()
+
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemaResp, err := server.GetProviderSchema(t.Context(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error: %v", err)
+	}
+
+	objectType, ok := schemaResp.ResourceSchemas[typeName].ValueType().(tftypes.Object)
+	if !ok {
+		t.Fatalf("unexpected schema type for %s", typeName)
+	}
+
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	attrs["name"] = tftypes.NewValue(tftypes.String, "test")
+
+	if _, ok := attrs["url"]; ok {
+		attrs["url"] = tftypes.NewValue(tftypes.String, "https://example.com")
+	}
+
+	if _, ok := attrs["keyword"]; ok {
+		attrs["keyword"] = tftypes.NewValue(tftypes.String, "ok")
+	}
+
+	if _, ok := attrs["json_path"]; ok {
+		attrs["json_path"] = tftypes.NewValue(tftypes.String, "$.status")
+		attrs["expected_value"] = tftypes.NewValue(tftypes.String, "ok")
+	}
+
+	maps.Copy(attrs, config)
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attrs))
+	if err != nil {
+		t.Fatalf("NewDynamicValue() error: %v", err)
+	}
+
+	resp, err := server.ValidateResourceConfig(t.Context(), &tfprotov6.ValidateResourceConfigRequest{
+		TypeName:           typeName,
+		Config:             &dynamicValue,
+		ClientCapabilities: &tfprotov6.ValidateResourceConfigClientCapabilities{WriteOnlyAttributesAllowed: true},
+	})
+	if err != nil {
+		t.Fatalf("ValidateResourceConfig() error: %v", err)
+	}
+
+	return resp.Diagnostics
+}
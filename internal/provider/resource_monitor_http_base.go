@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -15,35 +21,39 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var _ resource.ConfigValidator = httpBodyConfigValidator{}
+
 // MonitorHTTPBaseModel describes the base data model for HTTP-based monitor types.
 // This includes network config, authentication (Basic, NTLM, OAuth), and TLS settings.
 type MonitorHTTPBaseModel struct {
-	URL                 types.String `tfsdk:"url"`                   // HTTP(S) endpoint URL to monitor.
-	Timeout             types.Int64  `tfsdk:"timeout"`               // Request timeout in seconds.
-	Method              types.String `tfsdk:"method"`                // HTTP method (GET, POST, etc).
-	ExpiryNotification  types.Bool   `tfsdk:"expiry_notification"`   // Notify on certificate expiry.
-	IgnoreTLS           types.Bool   `tfsdk:"ignore_tls"`            // Skip TLS/SSL certificate validation.
-	MaxRedirects        types.Int64  `tfsdk:"max_redirects"`         // Maximum HTTP redirects to follow.
-	AcceptedStatusCodes types.List   `tfsdk:"accepted_status_codes"` // HTTP status codes to treat as success.
-	ProxyID             types.Int64  `tfsdk:"proxy_id"`              // Optional proxy ID for routing requests.
-	HTTPBodyEncoding    types.String `tfsdk:"http_body_encoding"`    // Encoding for request body.
-	Body                types.String `tfsdk:"body"`                  // Request body for POST/PUT methods.
-	Headers             types.String `tfsdk:"headers"`               // Custom HTTP headers as JSON.
-	AuthMethod          types.String `tfsdk:"auth_method"`           // Authentication method (basic, digest, ntlm, oauth).
-	BasicAuthUser       types.String `tfsdk:"basic_auth_user"`       // Basic auth username.
-	BasicAuthPass       types.String `tfsdk:"basic_auth_pass"`       // Basic auth password.
-	AuthDomain          types.String `tfsdk:"auth_domain"`           // Domain for NTLM authentication.
-	AuthWorkstation     types.String `tfsdk:"auth_workstation"`      // Workstation for NTLM authentication.
-	TLSCert             types.String `tfsdk:"tls_cert"`              // Client TLS certificate in PEM format.
-	TLSKey              types.String `tfsdk:"tls_key"`               // Client TLS key in PEM format.
-	TLSCa               types.String `tfsdk:"tls_ca"`                // CA certificate for server verification.
-	OAuthAuthMethod     types.String `tfsdk:"oauth_auth_method"`     // OAuth authentication method.
-	OAuthTokenURL       types.String `tfsdk:"oauth_token_url"`       // OAuth token endpoint URL.
-	OAuthClientID       types.String `tfsdk:"oauth_client_id"`       // OAuth client ID.
-	OAuthClientSecret   types.String `tfsdk:"oauth_client_secret"`   // OAuth client secret.
-	OAuthScopes         types.String `tfsdk:"oauth_scopes"`          // OAuth scopes to request.
-	OAuthAudience       types.String `tfsdk:"oauth_audience"`        // OAuth audience to request.
-	CacheBust           types.Bool   `tfsdk:"cache_buster"`          // Enable cache busting.
+	URL                 types.String        `tfsdk:"url"`                   // HTTP(S) endpoint URL to monitor.
+	Timeout             types.Int64         `tfsdk:"timeout"`               // Request timeout in seconds.
+	Method              types.String        `tfsdk:"method"`                // HTTP method (GET, POST, etc).
+	ExpiryNotification  types.Bool          `tfsdk:"expiry_notification"`   // Notify on certificate expiry.
+	IgnoreTLS           types.Bool          `tfsdk:"ignore_tls"`            // Skip TLS/SSL certificate validation.
+	MaxRedirects        types.Int64         `tfsdk:"max_redirects"`         // Maximum HTTP redirects to follow.
+	AcceptedStatusCodes types.List          `tfsdk:"accepted_status_codes"` // HTTP status codes to treat as success.
+	ProxyID             types.Int64         `tfsdk:"proxy_id"`              // Optional proxy ID for routing requests.
+	HTTPBodyEncoding    types.String        `tfsdk:"http_body_encoding"`    // Encoding for request body.
+	Body                NormalizedJSONValue `tfsdk:"body"`                  // Request body for POST/PUT methods.
+	Headers             NormalizedJSONValue `tfsdk:"headers"`               // Custom HTTP headers as JSON.
+	HeadersMap          types.Map           `tfsdk:"headers_map"`           // Custom HTTP headers as map.
+	SensitiveHeaders    types.Map           `tfsdk:"sensitive_headers"`     // Custom HTTP headers with redacted values.
+	AuthMethod          types.String        `tfsdk:"auth_method"`           // Authentication method (basic, digest, ntlm, oauth).
+	BasicAuthUser       types.String        `tfsdk:"basic_auth_user"`       // Basic auth username.
+	BasicAuthPass       types.String        `tfsdk:"basic_auth_pass"`       // Basic auth password.
+	AuthDomain          types.String        `tfsdk:"auth_domain"`           // Domain for NTLM authentication.
+	AuthWorkstation     types.String        `tfsdk:"auth_workstation"`      // Workstation for NTLM authentication.
+	TLSCert             types.String        `tfsdk:"tls_cert"`              // Client TLS certificate in PEM format.
+	TLSKey              types.String        `tfsdk:"tls_key"`               // Client TLS key in PEM format.
+	TLSCa               types.String        `tfsdk:"tls_ca"`                // CA certificate for server verification.
+	OAuthAuthMethod     types.String        `tfsdk:"oauth_auth_method"`     // OAuth authentication method.
+	OAuthTokenURL       types.String        `tfsdk:"oauth_token_url"`       // OAuth token endpoint URL.
+	OAuthClientID       types.String        `tfsdk:"oauth_client_id"`       // OAuth client ID.
+	OAuthClientSecret   types.String        `tfsdk:"oauth_client_secret"`   // OAuth client secret.
+	OAuthScopes         types.String        `tfsdk:"oauth_scopes"`          // OAuth scopes to request.
+	OAuthAudience       types.String        `tfsdk:"oauth_audience"`        // OAuth audience to request.
+	CacheBust           types.Bool          `tfsdk:"cache_buster"`          // Enable cache busting.
 }
 
 // withHTTPMonitorBaseAttributes adds HTTP-specific schema attributes to the provided attribute map.
@@ -60,6 +70,8 @@ func withHTTPMonitorBaseAttributes(attrs map[string]schema.Attribute) map[string
 	attrs["http_body_encoding"] = httpBodyEncodingAttribute()
 	attrs["body"] = httpBodyAttribute()
 	attrs["headers"] = httpHeadersAttribute()
+	attrs["headers_map"] = httpHeadersMapAttribute()
+	attrs["sensitive_headers"] = httpSensitiveHeadersAttribute()
 	attrs["auth_method"] = httpAuthMethodAttribute()
 	attrs["basic_auth_user"] = httpBasicAuthUserAttribute()
 	attrs["basic_auth_pass"] = httpBasicAuthPassAttribute()
@@ -172,24 +184,59 @@ func httpBodyEncodingAttribute() schema.StringAttribute {
 
 func httpBodyAttribute() schema.StringAttribute {
 	return schema.StringAttribute{
-		MarkdownDescription: "Request body",
+		MarkdownDescription: "Request body. With `http_body_encoding` set to `json`, the body must be valid JSON",
+		CustomType:          NormalizedJSONType{},
 		Optional:            true,
 	}
 }
 
 func httpHeadersAttribute() schema.StringAttribute {
 	return schema.StringAttribute{
-		MarkdownDescription: "Request headers (JSON format)",
+		MarkdownDescription: "Request headers (JSON format). Conflicts with `headers_map` and `sensitive_headers`",
+		CustomType:          NormalizedJSONType{},
 		Optional:            true,
+		Validators: []validator.String{
+			validateJSON(),
+		},
+	}
+}
+
+func httpHeadersMapAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Request headers as map of header name to value. Can be combined with " +
+			"`sensitive_headers`. Conflicts with `headers`",
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.Map{
+			mapvalidator.ConflictsWith(path.MatchRoot("headers")),
+		},
+	}
+}
+
+func httpSensitiveHeadersAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Request headers as map of header name to value, e.g. for `Authorization` headers " +
+			"containing secrets. The values are redacted in the plan output. Can be combined with `headers_map`. " +
+			"Conflicts with `headers`",
+		ElementType: types.StringType,
+		Optional:    true,
+		Sensitive:   true,
+		Validators: []validator.Map{
+			mapvalidator.ConflictsWith(path.MatchRoot("headers")),
+		},
 	}
 }
 
 func httpAuthMethodAttribute() schema.StringAttribute {
 	return schema.StringAttribute{
-		MarkdownDescription: "Authentication method",
-		Optional:            true,
-		Computed:            true,
-		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "Authentication method. Valid values: `basic` (requires `basic_auth_user` and " +
+			"`basic_auth_pass`), `ntlm` (requires `basic_auth_user` and `basic_auth_pass`, optionally " +
+			"`auth_domain` and `auth_workstation`), `oauth2-cc` (requires `oauth_token_url`, `oauth_client_id` " +
+			"and `oauth_client_secret`), `mtls` (requires `tls_cert` and `tls_key`, optionally `tls_ca`) or " +
+			"an empty string to disable authentication",
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(""),
 		Validators: []validator.String{
 			stringvalidator.OneOf("", "basic", "ntlm", "mtls", "oauth2-cc"),
 		},
@@ -230,6 +277,9 @@ func httpTLSCertAttribute() schema.StringAttribute {
 		MarkdownDescription: "TLS client certificate",
 		Optional:            true,
 		Sensitive:           true,
+		Validators: []validator.String{
+			pemCertificateValidator{},
+		},
 	}
 }
 
@@ -238,6 +288,9 @@ func httpTLSKeyAttribute() schema.StringAttribute {
 		MarkdownDescription: "TLS client key",
 		Optional:            true,
 		Sensitive:           true,
+		Validators: []validator.String{
+			pemPrivateKeyValidator{},
+		},
 	}
 }
 
@@ -245,6 +298,9 @@ func httpTLSCAAttribute() schema.StringAttribute {
 	return schema.StringAttribute{
 		MarkdownDescription: "TLS CA certificate",
 		Optional:            true,
+		Validators: []validator.String{
+			pemCertificateValidator{},
+		},
 	}
 }
 
@@ -299,3 +355,48 @@ func httpCacheBustAttribute() schema.BoolAttribute {
 		Default:             booldefault.StaticBool(false),
 	}
 }
+
+// httpBodyConfigValidator validates, that `body` contains valid JSON, if
+// `http_body_encoding` is `json`. Other encodings (e.g. `form` or `xml`)
+// send the body as is.
+type httpBodyConfigValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v httpBodyConfigValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (httpBodyConfigValidator) MarkdownDescription(_ context.Context) string {
+	return "`body` must be valid JSON, if `http_body_encoding` is `json`"
+}
+
+// ValidateResource validates the body of the configuration.
+func (httpBodyConfigValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var encoding types.String
+	var body NormalizedJSONValue
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http_body_encoding"), &encoding)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("body"), &body)...)
+	if resp.Diagnostics.HasError() || encoding.IsUnknown() || body.IsNull() || body.IsUnknown() {
+		return
+	}
+
+	// An unset encoding defaults to json.
+	if !encoding.IsNull() && encoding.ValueString() != "json" {
+		return
+	}
+
+	msg := jsonSyntaxError(body.ValueString())
+	if msg != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("body"),
+			"Invalid JSON",
+			fmt.Sprintf("The body must contain valid JSON, when \"http_body_encoding\" is \"json\", error at %s.", msg),
+		)
+	}
+}
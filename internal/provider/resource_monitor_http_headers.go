@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = httpHeadersConfigValidator{}
+
+// buildHTTPHeaders returns the request headers as JSON encoded object, as
+// expected by Uptime Kuma. The headers are either taken from `headers` or
+// merged from `headers_map` and `sensitive_headers`.
+func buildHTTPHeaders(ctx context.Context, m *MonitorHTTPBaseModel, diags *diag.Diagnostics) string {
+	if !m.Headers.IsNull() && !m.Headers.IsUnknown() {
+		return m.Headers.ValueString()
+	}
+
+	headers := map[string]string{}
+
+	for _, headersMap := range []types.Map{m.HeadersMap, m.SensitiveHeaders} {
+		if headersMap.IsNull() || headersMap.IsUnknown() {
+			continue
+		}
+
+		values := map[string]string{}
+		diags.Append(headersMap.ElementsAs(ctx, &values, false)...)
+		maps.Copy(headers, values)
+	}
+
+	if len(headers) == 0 {
+		return ""
+	}
+
+	// Keys are sorted by encoding/json, which keeps the result stable.
+	headersJSON, err := json.Marshal(headers)
+	// Handle error.
+	if err != nil {
+		diags.AddError("failed to encode headers", err.Error())
+		return ""
+	}
+
+	return string(headersJSON)
+}
+
+// populateHTTPHeaders populates `headers`, `headers_map` and
+// `sensitive_headers` from the JSON encoded headers returned by Uptime Kuma.
+// If the headers are managed with the maps, headers already known to be
+// sensitive are kept in `sensitive_headers` and all other headers are put
+// into `headers_map`. Otherwise, or if the headers are not a JSON object of
+// strings, they are kept in `headers`.
+func populateHTTPHeaders(apiHeaders string, m *MonitorHTTPBaseModel) {
+	useMaps := !m.HeadersMap.IsNull() || !m.SensitiveHeaders.IsNull()
+
+	var headers map[string]string
+
+	if useMaps && apiHeaders != "" {
+		err := json.Unmarshal([]byte(apiHeaders), &headers)
+		// Handle error.
+		if err != nil {
+			useMaps = false
+		}
+	}
+
+	if !useMaps {
+		m.Headers = normalizedJSONOrNull(apiHeaders)
+		m.HeadersMap = types.MapNull(types.StringType)
+		m.SensitiveHeaders = types.MapNull(types.StringType)
+
+		return
+	}
+
+	public := map[string]attr.Value{}
+	sensitive := map[string]attr.Value{}
+
+	for name, value := range headers {
+		if _, ok := m.SensitiveHeaders.Elements()[name]; ok {
+			sensitive[name] = types.StringValue(value)
+			continue
+		}
+
+		public[name] = types.StringValue(value)
+	}
+
+	// Populate state.
+	m.Headers = NewNormalizedJSONNull()
+	m.HeadersMap = stringMapOrNull(public, m.HeadersMap)
+	m.SensitiveHeaders = stringMapOrNull(sensitive, m.SensitiveHeaders)
+}
+
+// stringMapOrNull returns a map of the given values. An empty map is only
+// returned, if the previous value was not null, e.g. if it is configured as
+// `{}`.
+func stringMapOrNull(values map[string]attr.Value, previous types.Map) types.Map {
+	if len(values) == 0 && previous.IsNull() {
+		return types.MapNull(types.StringType)
+	}
+
+	return types.MapValueMust(types.StringType, values)
+}
+
+// httpHeadersConfigValidator validates, that a header is not configured in
+// both `headers_map` and `sensitive_headers`. Header names are compared
+// case-insensitively, like HTTP does.
+type httpHeadersConfigValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v httpHeadersConfigValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (httpHeadersConfigValidator) MarkdownDescription(_ context.Context) string {
+	return "a header must not be set in both `headers_map` and `sensitive_headers`"
+}
+
+// ValidateResource validates the headers of the configuration.
+func (httpHeadersConfigValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var headersMap, sensitiveHeaders types.Map
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("headers_map"), &headersMap)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sensitive_headers"), &sensitiveHeaders)...)
+	if resp.Diagnostics.HasError() || headersMap.IsNull() || headersMap.IsUnknown() ||
+		sensitiveHeaders.IsNull() || sensitiveHeaders.IsUnknown() {
+		return
+	}
+
+	public := map[string]string{}
+	for name := range headersMap.Elements() {
+		public[strings.ToLower(name)] = name
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(sensitiveHeaders.Elements())) {
+		publicName, ok := public[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sensitive_headers").AtMapKey(name),
+			"Duplicate Header",
+			fmt.Sprintf("The header %q is also set in \"headers_map\" as %q.", name, publicName),
+		)
+	}
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+)
+
+func testHeadersMap(headers map[string]string) types.Map {
+	if headers == nil {
+		return types.MapNull(types.StringType)
+	}
+
+	values := make(map[string]attr.Value, len(headers))
+	for name, value := range headers {
+		values[name] = types.StringValue(value)
+	}
+
+	return types.MapValueMust(types.StringType, values)
+}
+
+func TestHTTPHeadersRoundTrip(t *testing.T) {
+	tests := []struct {
+		name          string
+		model         MonitorHTTPBaseModel
+		wantJSON      string
+		apiHeaders    string
+		wantHeaders   NormalizedJSONValue
+		wantMap       types.Map
+		wantSensitive types.Map
+	}{
+		{
+			name: "no headers",
+			model: MonitorHTTPBaseModel{
+				Headers:          NewNormalizedJSONNull(),
+				HeadersMap:       testHeadersMap(nil),
+				SensitiveHeaders: testHeadersMap(nil),
+			},
+			wantHeaders:   NewNormalizedJSONNull(),
+			wantMap:       testHeadersMap(nil),
+			wantSensitive: testHeadersMap(nil),
+		},
+		{
+			name: "json headers",
+			model: MonitorHTTPBaseModel{
+				Headers:          NewNormalizedJSONValue(`{"Accept": "application/json"}`),
+				HeadersMap:       testHeadersMap(nil),
+				SensitiveHeaders: testHeadersMap(nil),
+			},
+			wantJSON:      `{"Accept": "application/json"}`,
+			wantHeaders:   NewNormalizedJSONValue(`{"Accept": "application/json"}`),
+			wantMap:       testHeadersMap(nil),
+			wantSensitive: testHeadersMap(nil),
+		},
+		{
+			name: "headers map and sensitive headers",
+			model: MonitorHTTPBaseModel{
+				Headers:          NewNormalizedJSONNull(),
+				HeadersMap:       testHeadersMap(map[string]string{"Accept": "application/json"}),
+				SensitiveHeaders: testHeadersMap(map[string]string{"Authorization": "Bearer secret"}),
+			},
+			wantJSON:      `{"Accept":"application/json","Authorization":"Bearer secret"}`,
+			wantHeaders:   NewNormalizedJSONNull(),
+			wantMap:       testHeadersMap(map[string]string{"Accept": "application/json"}),
+			wantSensitive: testHeadersMap(map[string]string{"Authorization": "Bearer secret"}),
+		},
+		{
+			name: "sensitive headers only",
+			model: MonitorHTTPBaseModel{
+				Headers:          NewNormalizedJSONNull(),
+				HeadersMap:       testHeadersMap(nil),
+				SensitiveHeaders: testHeadersMap(map[string]string{"Authorization": "Bearer secret"}),
+			},
+			wantJSON:      `{"Authorization":"Bearer secret"}`,
+			wantHeaders:   NewNormalizedJSONNull(),
+			wantMap:       testHeadersMap(nil),
+			wantSensitive: testHeadersMap(map[string]string{"Authorization": "Bearer secret"}),
+		},
+		{
+			name: "header added outside of terraform",
+			model: MonitorHTTPBaseModel{
+				Headers:          NewNormalizedJSONNull(),
+				HeadersMap:       testHeadersMap(nil),
+				SensitiveHeaders: testHeadersMap(map[string]string{"Authorization": "Bearer secret"}),
+			},
+			wantJSON:      `{"Authorization":"Bearer secret"}`,
+			apiHeaders:    `{"Authorization":"Bearer secret","X-Added":"1"}`,
+			wantHeaders:   NewNormalizedJSONNull(),
+			wantMap:       testHeadersMap(map[string]string{"X-Added": "1"}),
+			wantSensitive: testHeadersMap(map[string]string{"Authorization": "Bearer secret"}),
+		},
+		{
+			name: "headers not an object of strings",
+			model: MonitorHTTPBaseModel{
+				Headers:          NewNormalizedJSONNull(),
+				HeadersMap:       testHeadersMap(map[string]string{"Accept": "application/json"}),
+				SensitiveHeaders: testHeadersMap(nil),
+			},
+			wantJSON:      `{"Accept":"application/json"}`,
+			apiHeaders:    `{"Accept":1}`,
+			wantHeaders:   NewNormalizedJSONValue(`{"Accept":1}`),
+			wantMap:       testHeadersMap(nil),
+			wantSensitive: testHeadersMap(nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			got := buildHTTPHeaders(t.Context(), &tt.model, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got != tt.wantJSON {
+				t.Fatalf("buildHTTPHeaders() = %q, want %q", got, tt.wantJSON)
+			}
+
+			apiHeaders := got
+			if tt.apiHeaders != "" {
+				apiHeaders = tt.apiHeaders
+			}
+
+			// Read the headers back into the model, like Read does with the state.
+			m := MonitorHTTPResourceModel{MonitorHTTPBaseModel: tt.model}
+			populateHTTPMonitorBaseFieldsForHTTP(&monitor.HTTP{
+				HTTPDetails: monitor.HTTPDetails{Headers: apiHeaders},
+			}, &m)
+
+			if !m.Headers.Equal(tt.wantHeaders) {
+				t.Errorf("headers = %s, want %s", m.Headers, tt.wantHeaders)
+			}
+
+			if !m.HeadersMap.Equal(tt.wantMap) {
+				t.Errorf("headers_map = %s, want %s", m.HeadersMap, tt.wantMap)
+			}
+
+			if !m.SensitiveHeaders.Equal(tt.wantSensitive) {
+				t.Errorf("sensitive_headers = %s, want %s", m.SensitiveHeaders, tt.wantSensitive)
+			}
+		})
+	}
+}
+
+func TestHTTPHeadersConfigValidator(t *testing.T) {
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	headersMap := func(headers map[string]string) tftypes.Value {
+		values := make(map[string]tftypes.Value, len(headers))
+		for name, value := range headers {
+			values[name] = tftypes.NewValue(tftypes.String, value)
+		}
+
+		return tftypes.NewValue(mapType, values)
+	}
+
+	tests := []struct {
+		name    string
+		config  map[string]tftypes.Value
+		wantErr string
+	}{
+		{
+			name: "headers map and sensitive headers",
+			config: map[string]tftypes.Value{
+				"headers_map":       headersMap(map[string]string{"Accept": "application/json"}),
+				"sensitive_headers": headersMap(map[string]string{"Authorization": "Bearer secret"}),
+			},
+		},
+		{
+			name: "duplicate header",
+			config: map[string]tftypes.Value{
+				"headers_map":       headersMap(map[string]string{"authorization": "Bearer public"}),
+				"sensitive_headers": headersMap(map[string]string{"Authorization": "Bearer secret"}),
+			},
+			wantErr: "Duplicate Header",
+		},
+		{
+			name: "headers and headers map",
+			config: map[string]tftypes.Value{
+				"headers":     tftypes.NewValue(tftypes.String, `{"Accept": "application/json"}`),
+				"headers_map": headersMap(map[string]string{"Accept": "application/json"}),
+			},
+			wantErr: "Invalid Attribute Combination",
+		},
+		{
+			name: "headers and sensitive headers",
+			config: map[string]tftypes.Value{
+				"headers":           tftypes.NewValue(tftypes.String, `{"Accept": "application/json"}`),
+				"sensitive_headers": headersMap(map[string]string{"Authorization": "Bearer secret"}),
+			},
+			wantErr: "Invalid Attribute Combination",
+		},
+	}
+
+	for _, typeName := range []string{"uptimekuma_monitor_http", "uptimekuma_monitor_http_json_query"} {
+		for _, tt := range tests {
+			t.Run(typeName+"/"+tt.name, func(t *testing.T) {
+				diags := validateResourceConfigValues(t, typeName, tt.config)
+
+				var summaries []string
+				for _, d := range diags {
+					if d.Severity == tfprotov6.DiagnosticSeverityError {
+						summaries = append(summaries, d.Summary+": "+d.Detail)
+					}
+				}
+
+				if tt.wantErr == "" && len(summaries) > 0 {
+					t.Fatalf("unexpected diagnostics: %v", summaries)
+				}
+
+				if tt.wantErr != "" && !hasErrorDiagnostic(diags, tt.wantErr) {
+					t.Fatalf("expected diagnostic %q, got %v", tt.wantErr, summaries)
+				}
+			})
+		}
+	}
+}
+
+func TestAccMonitorHTTPResourceWithHeadersMap(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestHTTPMonitorHeadersMap")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorHTTPResourceConfigWithHeadersMap(name, "Bearer secret"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("headers_map"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"Accept": knownvalue.StringExact("application/json"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("sensitive_headers"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"Authorization": knownvalue.StringExact("Bearer secret"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("headers"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				Config: testAccMonitorHTTPResourceConfigWithHeadersMap(name, "Bearer rotated"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("sensitive_headers"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"Authorization": knownvalue.StringExact("Bearer rotated"),
+						}),
+					),
+				},
+			},
+			{
+				Config: providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_http" "test" {
+  name        = %[1]q
+  url         = "https://httpbin.org/headers"
+  headers     = jsonencode({ Accept = "application/json" })
+  headers_map = { Accept = "application/json" }
+}
+`, name),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccMonitorHTTPResourceConfigWithHeadersMap(name string, token string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_http" "test" {
+  name = %[1]q
+  url  = "https://httpbin.org/headers"
+
+  headers_map = {
+    Accept = "application/json"
+  }
+
+  sensitive_headers = {
+    Authorization = %[2]q
+  }
+}
+`, name, token)
+}
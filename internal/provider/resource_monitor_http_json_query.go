@@ -21,8 +21,11 @@ import (
 
 var (
 	// Ensure MonitorHTTPJSONQueryResource satisfies various resource interfaces.
-	_ resource.Resource                = &MonitorHTTPJSONQueryResource{}
-	_ resource.ResourceWithImportState = &MonitorHTTPJSONQueryResource{}
+	_ resource.Resource                     = &MonitorHTTPJSONQueryResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorHTTPJSONQueryResource{}
+	_ resource.ResourceWithImportState      = &MonitorHTTPJSONQueryResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorHTTPJSONQueryResource{}
+	_ resource.ResourceWithMoveState        = &MonitorHTTPJSONQueryResource{}
 )
 
 // NewMonitorHTTPJSONQueryResource returns a new instance of the HTTP JSON Query monitor resource.
@@ -32,7 +35,8 @@ func NewMonitorHTTPJSONQueryResource() resource.Resource {
 
 // MonitorHTTPJSONQueryResource defines the resource implementation.
 type MonitorHTTPJSONQueryResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorHTTPJSONQueryResourceModel describes the resource data model for HTTP JSON Query monitors.
@@ -90,6 +94,22 @@ func (*MonitorHTTPJSONQueryResource) Schema(
 	}
 }
 
+// ConfigValidators returns the config validators for the resource.
+func (*MonitorHTTPJSONQueryResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return httpMonitorConfigValidators()
+}
+
+// ModifyPlan validates the references to other objects at plan time and
+// forces the update of a moved monitor, whose type has not been changed yet.
+func (r *MonitorHTTPJSONQueryResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+	planMonitorTypeChange(ctx, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorHTTPJSONQueryResource) Configure(
 	_ context.Context,
@@ -97,6 +117,7 @@ func (r *MonitorHTTPJSONQueryResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -123,7 +144,7 @@ func (r *MonitorHTTPJSONQueryResource) Create(
 	}
 
 	data.ID = types.Int64Value(id)
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -165,7 +186,7 @@ func buildHTTPJSONQueryMonitor(
 			AcceptedStatusCodes:      []string{},
 			HTTPBodyEncoding:         data.HTTPBodyEncoding.ValueString(),
 			Body:                     data.Body.ValueString(),
-			Headers:                  data.Headers.ValueString(),
+			Headers:                  buildHTTPHeaders(ctx, &data.MonitorHTTPBaseModel, diags),
 			AuthMethod:               monitor.AuthMethod(data.AuthMethod.ValueString()),
 			BasicAuthUser:            data.BasicAuthUser.ValueString(),
 			BasicAuthPass:            data.BasicAuthPass.ValueString(),
@@ -259,8 +280,8 @@ func populateHTTPBaseFieldsForJSONQuery(httpMonitor *monitor.HTTP, m *MonitorHTT
 	m.IgnoreTLS = types.BoolValue(httpMonitor.IgnoreTLS)
 	m.MaxRedirects = types.Int64Value(int64(httpMonitor.MaxRedirects))
 	m.HTTPBodyEncoding = types.StringValue(httpMonitor.HTTPBodyEncoding)
-	m.Body = stringOrNullJSONQuery(httpMonitor.Body)
-	m.Headers = stringOrNullJSONQuery(httpMonitor.Headers)
+	m.Body = normalizedJSONOrNull(httpMonitor.Body)
+	populateHTTPHeaders(httpMonitor.Headers, &m.MonitorHTTPBaseModel)
 	m.AuthMethod = types.StringValue(string(httpMonitor.AuthMethod))
 	m.BasicAuthUser = stringOrNullJSONQuery(httpMonitor.BasicAuthUser)
 	m.BasicAuthPass = stringOrNullJSONQuery(httpMonitor.BasicAuthPass)
@@ -345,7 +366,8 @@ func (r *MonitorHTTPJSONQueryResource) Read(
 		return
 	}
 
-	if actual := httpJSONQueryMonitor.Base.Type(); actual != "" && actual != httpJSONQueryMonitor.Type() {
+	if actual := httpJSONQueryMonitor.Base.Type(); actual != "" && actual != httpJSONQueryMonitor.Type() &&
+		!isMonitorTypeChangePending(ctx, req.Private) {
 		tflog.Warn(ctx, "monitor type changed externally, removing from state", map[string]any{
 			"id":            data.ID.ValueInt64(),
 			"expected_type": httpJSONQueryMonitor.Type(),
@@ -391,6 +413,9 @@ func (r *MonitorHTTPJSONQueryResource) Update(
 		return
 	}
 
+	// The ID is unknown in the plan of a moved monitor.
+	data.ID = state.ID
+
 	httpJSONQueryMonitor := buildHTTPJSONQueryMonitor(ctx, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -404,7 +429,15 @@ func (r *MonitorHTTPJSONQueryResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -415,6 +448,18 @@ func (r *MonitorHTTPJSONQueryResource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// The type of a moved monitor is changed by this update.
+	if isMonitorTypeChangePending(ctx, req.Private) {
+		completeMonitorTypeChange(
+			ctx,
+			r.client,
+			data.ID.ValueInt64(),
+			httpJSONQueryMonitor.Type(),
+			resp.Private,
+			&resp.Diagnostics,
+		)
+	}
 }
 
 // Delete deletes the resource.
@@ -461,3 +506,8 @@ func (*MonitorHTTPJSONQueryResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of the other HTTP-based monitors into this resource.
+func (*MonitorHTTPJSONQueryResource) MoveState(ctx context.Context) []resource.StateMover {
+	return moveMonitorState(ctx, NewMonitorHTTPResource, NewMonitorHTTPKeywordResource)
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccMonitorHTTPJSONQueryResource(t *testing.T) {
@@ -505,3 +507,50 @@ resource "uptimekuma_monitor_http_json_query" "test" {
 }
 `, name, url)
 }
+
+func TestAccMonitorHTTPJSONQueryResourceMoveFromHTTP(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestHTTPJSONQueryMonitorMoved")
+	kumaClient := testAccOutOfBandClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorHTTPResourceConfig(name, "https://httpbin.org/json", "GET", 60, 48),
+			},
+			{
+				// The update is planned even if no attribute changes, as it
+				// changes the type of the monitor.
+				Config: testAccMonitorHTTPJSONQueryResourceConfig(
+					name, "https://httpbin.org/json", "$.slideshow.author", "Yours Truly", "==", 60, 48, false,
+				) + `
+moved {
+  from = uptimekuma_monitor_http.test
+  to   = uptimekuma_monitor_http_json_query.test
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectResourceAction("uptimekuma_monitor_http_json_query.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckMonitorType(t, kumaClient, "uptimekuma_monitor_http_json_query.test", "json-query"),
+			},
+			{
+				Config: testAccMonitorHTTPJSONQueryResourceConfig(
+					name, "https://httpbin.org/json", "$.slideshow.author", "Yours Truly", "==", 60, 48, false,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -21,8 +21,11 @@ import (
 
 var (
 	// Ensure MonitorHTTPKeywordResource satisfies various resource interfaces.
-	_ resource.Resource                = &MonitorHTTPKeywordResource{}
-	_ resource.ResourceWithImportState = &MonitorHTTPKeywordResource{}
+	_ resource.Resource                     = &MonitorHTTPKeywordResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorHTTPKeywordResource{}
+	_ resource.ResourceWithImportState      = &MonitorHTTPKeywordResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorHTTPKeywordResource{}
+	_ resource.ResourceWithMoveState        = &MonitorHTTPKeywordResource{}
 )
 
 // NewMonitorHTTPKeywordResource returns a new instance of the HTTP Keyword monitor resource.
@@ -32,7 +35,8 @@ func NewMonitorHTTPKeywordResource() resource.Resource {
 
 // MonitorHTTPKeywordResource defines the resource implementation.
 type MonitorHTTPKeywordResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorHTTPKeywordResourceModel describes the resource data model for HTTP Keyword monitors.
@@ -82,6 +86,22 @@ func (*MonitorHTTPKeywordResource) Schema(
 	}
 }
 
+// ConfigValidators returns the config validators for the resource.
+func (*MonitorHTTPKeywordResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return httpMonitorConfigValidators()
+}
+
+// ModifyPlan validates the references to other objects at plan time and
+// forces the update of a moved monitor, whose type has not been changed yet.
+func (r *MonitorHTTPKeywordResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+	planMonitorTypeChange(ctx, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorHTTPKeywordResource) Configure(
 	_ context.Context,
@@ -89,6 +109,7 @@ func (r *MonitorHTTPKeywordResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -115,7 +136,7 @@ func (r *MonitorHTTPKeywordResource) Create(
 	}
 
 	data.ID = types.Int64Value(id)
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -157,7 +178,7 @@ func buildHTTPKeywordMonitor(
 			AcceptedStatusCodes:      []string{},
 			HTTPBodyEncoding:         data.HTTPBodyEncoding.ValueString(),
 			Body:                     data.Body.ValueString(),
-			Headers:                  data.Headers.ValueString(),
+			Headers:                  buildHTTPHeaders(ctx, &data.MonitorHTTPBaseModel, diags),
 			AuthMethod:               monitor.AuthMethod(data.AuthMethod.ValueString()),
 			BasicAuthUser:            data.BasicAuthUser.ValueString(),
 			BasicAuthPass:            data.BasicAuthPass.ValueString(),
@@ -249,8 +270,8 @@ func populateHTTPBaseFieldsForKeyword(httpMonitor *monitor.HTTP, m *MonitorHTTPK
 	m.IgnoreTLS = types.BoolValue(httpMonitor.IgnoreTLS)
 	m.MaxRedirects = types.Int64Value(int64(httpMonitor.MaxRedirects))
 	m.HTTPBodyEncoding = types.StringValue(httpMonitor.HTTPBodyEncoding)
-	m.Body = stringOrNullKeyword(httpMonitor.Body)
-	m.Headers = stringOrNullKeyword(httpMonitor.Headers)
+	m.Body = normalizedJSONOrNull(httpMonitor.Body)
+	populateHTTPHeaders(httpMonitor.Headers, &m.MonitorHTTPBaseModel)
 	m.AuthMethod = types.StringValue(string(httpMonitor.AuthMethod))
 	m.BasicAuthUser = stringOrNullKeyword(httpMonitor.BasicAuthUser)
 	m.BasicAuthPass = stringOrNullKeyword(httpMonitor.BasicAuthPass)
@@ -331,7 +352,8 @@ func (r *MonitorHTTPKeywordResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	if actual := httpKeywordMonitor.Base.Type(); actual != "" && actual != httpKeywordMonitor.Type() {
+	if actual := httpKeywordMonitor.Base.Type(); actual != "" && actual != httpKeywordMonitor.Type() &&
+		!isMonitorTypeChangePending(ctx, req.Private) {
 		tflog.Warn(ctx, "monitor type changed externally, removing from state", map[string]any{
 			"id":            data.ID.ValueInt64(),
 			"expected_type": httpKeywordMonitor.Type(),
@@ -376,6 +398,9 @@ func (r *MonitorHTTPKeywordResource) Update(
 		return
 	}
 
+	// The ID is unknown in the plan of a moved monitor.
+	data.ID = state.ID
+
 	httpKeywordMonitor := buildHTTPKeywordMonitor(ctx, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -389,7 +414,15 @@ func (r *MonitorHTTPKeywordResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -400,6 +433,18 @@ func (r *MonitorHTTPKeywordResource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// The type of a moved monitor is changed by this update.
+	if isMonitorTypeChangePending(ctx, req.Private) {
+		completeMonitorTypeChange(
+			ctx,
+			r.client,
+			data.ID.ValueInt64(),
+			httpKeywordMonitor.Type(),
+			resp.Private,
+			&resp.Diagnostics,
+		)
+	}
 }
 
 // Delete deletes the resource.
@@ -446,3 +491,8 @@ func (*MonitorHTTPKeywordResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of the other HTTP-based monitors into this resource.
+func (*MonitorHTTPKeywordResource) MoveState(ctx context.Context) []resource.StateMover {
+	return moveMonitorState(ctx, NewMonitorHTTPResource, NewMonitorHTTPJSONQueryResource)
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccMonitorHTTPKeywordResource(t *testing.T) {
@@ -439,3 +441,51 @@ resource "uptimekuma_monitor_http_keyword" "test" {
 }
 `, name)
 }
+
+func TestAccMonitorHTTPKeywordResourceMoveFromHTTP(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestHTTPKeywordMonitorMoved")
+	url := "https://httpbin.org/html"
+	kumaClient := testAccOutOfBandClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorHTTPResourceConfig(name, url, "GET", 60, 48),
+			},
+			{
+				Config: testAccMonitorHTTPKeywordResourceConfig(name, url, "Herman", false, 60, 48) + `
+moved {
+  from = uptimekuma_monitor_http.test
+  to   = uptimekuma_monitor_http_keyword.test
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"uptimekuma_monitor_http_keyword.test",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http_keyword.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(name),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http_keyword.test",
+						tfjsonpath.New("keyword"),
+						knownvalue.StringExact("Herman"),
+					),
+				},
+				Check: testAccCheckMonitorType(t, kumaClient, "uptimekuma_monitor_http_keyword.test", "keyword"),
+			},
+		},
+	})
+}
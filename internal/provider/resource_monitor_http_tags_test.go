@@ -2,12 +2,21 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
@@ -180,3 +189,273 @@ resource "uptimekuma_monitor_http" "test" {
 }
 `, monitorName, url, tagName2)
 }
+
+func TestMonitorTagsConfigValidation(t *testing.T) {
+	tagType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"tag_id": tftypes.Number,
+		"name":   tftypes.String,
+		"value":  tftypes.String,
+	}}
+	tags := func(tagID any, name any) tftypes.Value {
+		return tftypes.NewValue(tftypes.Set{ElementType: tagType}, []tftypes.Value{
+			tftypes.NewValue(tagType, map[string]tftypes.Value{
+				"tag_id": tftypes.NewValue(tftypes.Number, tagID),
+				"name":   tftypes.NewValue(tftypes.String, name),
+				"value":  tftypes.NewValue(tftypes.String, nil),
+			}),
+		})
+	}
+
+	tests := []struct {
+		name    string
+		tags    tftypes.Value
+		wantErr string
+	}{
+		{name: "tag id", tags: tags(3, nil)},
+		{name: "name", tags: tags(nil, "env")},
+		{name: "tag id and name", tags: tags(3, "env"), wantErr: "Invalid Attribute Combination"},
+		{name: "neither tag id nor name", tags: tags(nil, nil), wantErr: "Invalid Attribute Combination"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfigValues(t, "uptimekuma_monitor_http", map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "example"),
+				"url":  tftypes.NewValue(tftypes.String, "https://example.com"),
+				"tags": tt.tags,
+			})
+
+			var summaries []string
+			for _, d := range diags {
+				if d.Severity == tfprotov6.DiagnosticSeverityError {
+					summaries = append(summaries, d.Summary+": "+d.Detail)
+				}
+			}
+
+			if tt.wantErr == "" && len(summaries) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", summaries)
+			}
+
+			if tt.wantErr != "" && !hasErrorDiagnostic(diags, tt.wantErr) {
+				t.Fatalf("expected diagnostic %q, got %v", tt.wantErr, summaries)
+			}
+		})
+	}
+}
+
+func TestUseStateForResolvedMonitorTags(t *testing.T) {
+	tagType := types.ObjectType{AttrTypes: monitorTagAttrTypes()}
+	tag := func(tagID types.Int64, name types.String, value types.String) attr.Value {
+		return types.ObjectValueMust(tagType.AttrTypes, map[string]attr.Value{
+			"tag_id": tagID,
+			"name":   name,
+			"value":  value,
+		})
+	}
+
+	state := types.SetValueMust(tagType, []attr.Value{
+		tag(types.Int64Value(3), types.StringValue("env"), types.StringValue("prod")),
+		tag(types.Int64Value(4), types.StringValue("team"), types.StringNull()),
+	})
+
+	tests := []struct {
+		name string
+		plan types.Set
+		want types.Set
+	}{
+		{
+			name: "resolved by name",
+			plan: types.SetValueMust(tagType, []attr.Value{
+				tag(types.Int64Unknown(), types.StringValue("env"), types.StringValue("prod")),
+			}),
+			want: types.SetValueMust(tagType, []attr.Value{
+				tag(types.Int64Value(3), types.StringValue("env"), types.StringValue("prod")),
+			}),
+		},
+		{
+			name: "resolved by tag id",
+			plan: types.SetValueMust(tagType, []attr.Value{
+				tag(types.Int64Value(4), types.StringUnknown(), types.StringNull()),
+			}),
+			want: types.SetValueMust(tagType, []attr.Value{
+				tag(types.Int64Value(4), types.StringValue("team"), types.StringNull()),
+			}),
+		},
+		{
+			name: "changed value",
+			plan: types.SetValueMust(tagType, []attr.Value{
+				tag(types.Int64Unknown(), types.StringValue("env"), types.StringValue("dev")),
+			}),
+			want: types.SetValueMust(tagType, []attr.Value{
+				tag(types.Int64Unknown(), types.StringValue("env"), types.StringValue("dev")),
+			}),
+		},
+		{
+			name: "new tag",
+			plan: types.SetValueMust(tagType, []attr.Value{
+				tag(types.Int64Unknown(), types.StringValue("region"), types.StringNull()),
+			}),
+			want: types.SetValueMust(tagType, []attr.Value{
+				tag(types.Int64Unknown(), types.StringValue("region"), types.StringNull()),
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.SetRequest{StateValue: state, PlanValue: tt.plan}
+			resp := &planmodifier.SetResponse{PlanValue: req.PlanValue}
+
+			useStateForResolvedMonitorTags{}.PlanModifySet(t.Context(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Fatalf("plan = %s, want %s", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccMonitorHTTPResourceWithTagsByName(t *testing.T) {
+	monitorName := acctest.RandomWithPrefix("TestHTTPMonitorWithTagsByName")
+	tagName := acctest.RandomWithPrefix("TestTagAutoCreated")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMonitorHTTPResourceConfigWithTagByName(monitorName, tagName, false),
+				ExpectError: regexp.MustCompile(`Unknown Tag`),
+			},
+			{
+				Config: testAccMonitorHTTPResourceConfigWithTagByName(monitorName, tagName, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("tags").AtSliceIndex(0).AtMapKey("name"),
+						knownvalue.StringExact(tagName),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("tags").AtSliceIndex(0).AtMapKey("tag_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				// The resolved tag ID must not cause a diff.
+				Config: testAccMonitorHTTPResourceConfigWithTagByName(monitorName, tagName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorHTTPResourceConfigWithTagByName(monitorName string, tagName string, autoCreate bool) string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  endpoint         = %[1]q
+  username         = %[2]q
+  password         = %[3]q
+  auto_create_tags = %[6]t
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  name = %[4]q
+  url  = "https://example.com"
+
+  tags = [
+    {
+      name  = %[5]q
+      value = "production"
+    },
+  ]
+}
+`, endpoint, username, password, monitorName, tagName, autoCreate)
+}
+
+func TestAccMonitorHTTPResourceWithSameNewTagByName(t *testing.T) {
+	monitorName := acctest.RandomWithPrefix("TestHTTPMonitorWithSameNewTag")
+	tagName := acctest.RandomWithPrefix("TestTagAutoCreatedOnce")
+	kumaClient := testAccOutOfBandClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Both monitors are created in parallel and must share a single new tag.
+				Config: testAccMonitorHTTPResourceConfigWithSameTagByName(monitorName, tagName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"uptimekuma_monitor_http.first",
+						tfjsonpath.New("tags").AtSliceIndex(0).AtMapKey("tag_id"),
+						"uptimekuma_monitor_http.second",
+						tfjsonpath.New("tags").AtSliceIndex(0).AtMapKey("tag_id"),
+						compare.ValuesSame(),
+					),
+				},
+				Check: func(*terraform.State) error {
+					tags, err := kumaClient.GetTags(t.Context())
+					if err != nil {
+						return fmt.Errorf("read tags: %w", err)
+					}
+
+					count := 0
+					for _, tag := range tags {
+						if tag.Name == tagName {
+							count++
+						}
+					}
+
+					if count != 1 {
+						return fmt.Errorf("found %d tags with name %q, want 1", count, tagName)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorHTTPResourceConfigWithSameTagByName(monitorName string, tagName string) string {
+	return fmt.Sprintf(`
+provider "uptimekuma" {
+  endpoint         = %[1]q
+  username         = %[2]q
+  password         = %[3]q
+  auto_create_tags = true
+}
+
+resource "uptimekuma_monitor_http" "first" {
+  name = "%[4]s-first"
+  url  = "https://example.com"
+
+  tags = [
+    {
+      name = %[5]q
+    },
+  ]
+}
+
+resource "uptimekuma_monitor_http" "second" {
+  name = "%[4]s-second"
+  url  = "https://example.com"
+
+  tags = [
+    {
+      name = %[5]q
+    },
+  ]
+}
+`, endpoint, username, password, monitorName, tagName)
+}
@@ -2,13 +2,16 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccMonitorHTTPResource(t *testing.T) {
@@ -417,3 +420,170 @@ resource "uptimekuma_monitor_http" "test" {
 }
 `, name, url)
 }
+
+func TestAccMonitorHTTPResourceInvalidAuthentication(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestHTTPMonitorInvalidAuth")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_http" "test" {
+  name            = %[1]q
+  url             = "https://httpbin.org/basic-auth/user/pass"
+  auth_method     = "basic"
+  basic_auth_user = "user"
+}
+`, name),
+				ExpectError: regexp.MustCompile(`The attribute "basic_auth_pass" is required`),
+			},
+			{
+				Config: providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_http" "test" {
+  name     = %[1]q
+  url      = "https://httpbin.org/status/200"
+  tls_cert = "not a certificate"
+}
+`, name),
+				ExpectError: regexp.MustCompile(`Invalid PEM Certificate`),
+			},
+		},
+	})
+}
+
+func TestAccMonitorHTTPResourceJSONFormatting(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestHTTPMonitorJSONFormatting")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorHTTPResourceConfigWithJSON(
+					name,
+					`{"Accept":"application/json","X-Custom":"value"}`,
+					`{"count":1,"items":["a","b"]}`,
+				),
+			},
+			{
+				// Reformatted JSON with a different key order must not result in a diff.
+				Config: testAccMonitorHTTPResourceConfigWithJSON(
+					name,
+					"{\n  \"X-Custom\": \"value\",\n  \"Accept\": \"application/json\"\n}",
+					"{\n  \"items\": [\"a\", \"b\"],\n  \"count\": 1.0\n}",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config:      testAccMonitorHTTPResourceConfigWithJSON(name, `{"Accept": }`, `{}`),
+				ExpectError: regexp.MustCompile(`Invalid JSON`),
+			},
+		},
+	})
+}
+
+func testAccMonitorHTTPResourceConfigWithJSON(name string, headers string, body string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_http" "test" {
+  name    = %[1]q
+  url     = "https://httpbin.org/anything"
+  method  = "POST"
+  headers = %[2]q
+  body    = %[3]q
+}
+`, name, headers, body)
+}
+
+func TestAccMonitorHTTPResourceMoveFromKeyword(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestHTTPMonitorMovedFromKeyword")
+	kumaClient := testAccOutOfBandClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorHTTPKeywordResourceConfig(name, "https://httpbin.org/html", "Herman", false, 60, 48),
+			},
+			{
+				// The update is planned even if no attribute changes, as it
+				// changes the type of the monitor.
+				Config: testAccMonitorHTTPResourceConfig(name, "https://httpbin.org/html", "GET", 60, 48) + `
+moved {
+  from = uptimekuma_monitor_http_keyword.test
+  to   = uptimekuma_monitor_http.test
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectResourceAction("uptimekuma_monitor_http.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckMonitorType(t, kumaClient, "uptimekuma_monitor_http.test", "http"),
+			},
+			{
+				Config: testAccMonitorHTTPResourceConfig(name, "https://httpbin.org/html", "GET", 60, 48),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccMonitorHTTPResourceMoveFromJSONQuery(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestHTTPMonitorMovedFromJSONQuery")
+	kumaClient := testAccOutOfBandClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorHTTPJSONQueryResourceConfig(
+					name, "https://httpbin.org/json", "$.slideshow.author", "Yours Truly", "==", 60, 48, false,
+				),
+			},
+			{
+				// The update is planned even if no attribute changes, as it
+				// changes the type of the monitor.
+				Config: testAccMonitorHTTPResourceConfig(name, "https://httpbin.org/json", "GET", 60, 48) + `
+moved {
+  from = uptimekuma_monitor_http_json_query.test
+  to   = uptimekuma_monitor_http.test
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectResourceAction("uptimekuma_monitor_http.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckMonitorType(t, kumaClient, "uptimekuma_monitor_http.test", "http"),
+			},
+			{
+				Config: testAccMonitorHTTPResourceConfig(name, "https://httpbin.org/json", "GET", 60, 48),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
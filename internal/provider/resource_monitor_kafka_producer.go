@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -20,8 +21,10 @@ import (
 
 var (
 	// Ensure MonitorKafkaProducerResource satisfies various resource interfaces.
-	_ resource.Resource                = &MonitorKafkaProducerResource{}
-	_ resource.ResourceWithImportState = &MonitorKafkaProducerResource{}
+	_ resource.Resource                     = &MonitorKafkaProducerResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorKafkaProducerResource{}
+	_ resource.ResourceWithImportState      = &MonitorKafkaProducerResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorKafkaProducerResource{}
 )
 
 // NewMonitorKafkaProducerResource returns a new instance of the Kafka Producer monitor resource.
@@ -31,19 +34,21 @@ func NewMonitorKafkaProducerResource() resource.Resource {
 
 // MonitorKafkaProducerResource defines the resource implementation.
 type MonitorKafkaProducerResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorKafkaProducerResourceModel describes the resource data model for Kafka Producer monitors.
 type MonitorKafkaProducerResourceModel struct {
 	MonitorBaseModel
 
-	Brokers                types.List   `tfsdk:"brokers"`
-	Topic                  types.String `tfsdk:"topic"`
-	Message                types.String `tfsdk:"message"`
-	SSL                    types.Bool   `tfsdk:"ssl"`
-	AllowAutoTopicCreation types.Bool   `tfsdk:"allow_auto_topic_creation"`
-	SASLOptions            types.String `tfsdk:"sasl_options"`
+	Brokers                types.List          `tfsdk:"brokers"`
+	Topic                  types.String        `tfsdk:"topic"`
+	Message                types.String        `tfsdk:"message"`
+	SSL                    types.Bool          `tfsdk:"ssl"`
+	AllowAutoTopicCreation types.Bool          `tfsdk:"allow_auto_topic_creation"`
+	SASLOptions            NormalizedJSONValue `tfsdk:"sasl_options"`
+	SASL                   types.Object        `tfsdk:"sasl"`
 }
 
 // Metadata returns the metadata for the resource.
@@ -92,14 +97,36 @@ func (*MonitorKafkaProducerResource) Schema(
 			},
 			"sasl_options": schema.StringAttribute{
 				MarkdownDescription: "SASL authentication options as a JSON-encoded object (e.g. " +
-					"`{\"mechanism\":\"plain\",\"username\":\"u\",\"password\":\"p\"}`).",
-				Optional:  true,
-				Sensitive: true,
+					"`{\"mechanism\":\"plain\",\"username\":\"u\",\"password\":\"p\"}`). " +
+					"Prefer the validated `sasl` attribute. Conflicts with `sasl`.",
+				CustomType: NormalizedJSONType{},
+				Optional:   true,
+				Sensitive:  true,
+				Validators: []validator.String{
+					validateJSON(),
+				},
 			},
+			"sasl": kafkaSASLAttribute(),
 		}),
 	}
 }
 
+// ConfigValidators returns the validators of the SASL configuration.
+func (*MonitorKafkaProducerResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		kafkaSASLConfigValidator{},
+	}
+}
+
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorKafkaProducerResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorKafkaProducerResource) Configure(
 	_ context.Context,
@@ -107,6 +134,7 @@ func (r *MonitorKafkaProducerResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new Kafka Producer monitor resource.
@@ -134,7 +162,7 @@ func (r *MonitorKafkaProducerResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -196,6 +224,15 @@ func buildKafkaProducerMonitor(
 		kafkaMonitor.SASLOptions = &saslOptions
 	}
 
+	if !data.SASL.IsNull() && !data.SASL.IsUnknown() {
+		saslOptions := buildKafkaSASLOptions(ctx, data.SASL, diags)
+		if diags.HasError() {
+			return kafkaMonitor
+		}
+
+		kafkaMonitor.SASLOptions = &saslOptions
+	}
+
 	if !data.Description.IsNull() {
 		desc := data.Description.ValueString()
 		kafkaMonitor.Description = &desc
@@ -256,6 +293,16 @@ func populateKafkaProducerMonitorBaseFields(
 	} else {
 		m.Brokers = types.ListNull(types.StringType)
 	}
+
+	// SASL options configured as raw JSON are kept as they are in the state.
+	if m.SASLOptions.IsNull() {
+		var saslOptions map[string]any
+		if kafkaMonitor.SASLOptions != nil {
+			saslOptions = *kafkaMonitor.SASLOptions
+		}
+
+		m.SASL = flattenKafkaSASLOptions(saslOptions)
+	}
 }
 
 // populateOptionalFieldsForKafkaProducer populates optional parent and notification fields from the Kafka Producer
@@ -359,7 +406,15 @@ func (r *MonitorKafkaProducerResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	kafkaSASLMechanismPlain       = "plain"
+	kafkaSASLMechanismScramSHA256 = "scram-sha-256"
+	kafkaSASLMechanismScramSHA512 = "scram-sha-512"
+	kafkaSASLMechanismAWS         = "aws"
+)
+
+var _ resource.ConfigValidator = kafkaSASLConfigValidator{}
+
+// MonitorKafkaProducerSASLModel describes the SASL authentication nested object data model.
+type MonitorKafkaProducerSASLModel struct {
+	Mechanism             types.String `tfsdk:"mechanism"`
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+	AuthorizationIdentity types.String `tfsdk:"authorization_identity"`
+	AccessKeyID           types.String `tfsdk:"access_key_id"`
+	SecretAccessKey       types.String `tfsdk:"secret_access_key"`
+	SessionToken          types.String `tfsdk:"session_token"`
+}
+
+// kafkaSASLMechanisms returns the SASL mechanisms supported by Uptime Kuma.
+func kafkaSASLMechanisms() []string {
+	return []string{
+		kafkaSASLMechanismPlain,
+		kafkaSASLMechanismScramSHA256,
+		kafkaSASLMechanismScramSHA512,
+		kafkaSASLMechanismAWS,
+	}
+}
+
+// kafkaSASLAttrTypes returns the attribute types of the `sasl` object.
+func kafkaSASLAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"mechanism":              types.StringType,
+		"username":               types.StringType,
+		"password":               types.StringType,
+		"authorization_identity": types.StringType,
+		"access_key_id":          types.StringType,
+		"secret_access_key":      types.StringType,
+		"session_token":          types.StringType,
+	}
+}
+
+// kafkaSASLAttribute returns the schema of the `sasl` attribute.
+func kafkaSASLAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "SASL authentication of the Kafka connection. Conflicts with `sasl_options`.",
+		Optional:            true,
+		Validators: []validator.Object{
+			// `sasl_options_wo` is added by withWriteOnlySecrets.
+			objectvalidator.ConflictsWith(path.MatchRoot("sasl_options"), path.MatchRoot("sasl_options_wo")),
+		},
+		Attributes: map[string]schema.Attribute{
+			"mechanism": schema.StringAttribute{
+				MarkdownDescription: "SASL mechanism. Valid values: " + quotedList(kafkaSASLMechanisms()) + ".",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(kafkaSASLMechanisms()...),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "SASL username. Required for the `plain` and `scram-*` mechanisms.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "SASL password. Required for the `plain` and `scram-*` mechanisms.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"authorization_identity": schema.StringAttribute{
+				MarkdownDescription: "AWS authorization identity (the user or role ID). Required for the `aws` mechanism.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"access_key_id": schema.StringAttribute{
+				MarkdownDescription: "AWS access key ID. Required for the `aws` mechanism.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				MarkdownDescription: "AWS secret access key. Required for the `aws` mechanism.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"session_token": schema.StringAttribute{
+				MarkdownDescription: "AWS session token. Only used by the `aws` mechanism.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// buildKafkaSASLOptions returns the SASL options in the format expected by
+// Uptime Kuma.
+func buildKafkaSASLOptions(ctx context.Context, obj types.Object, diags *diag.Diagnostics) map[string]any {
+	var sasl MonitorKafkaProducerSASLModel
+
+	diags.Append(obj.As(ctx, &sasl, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	options := map[string]any{
+		"mechanism": sasl.Mechanism.ValueString(),
+	}
+
+	if sasl.Mechanism.ValueString() == kafkaSASLMechanismAWS {
+		options["authorizationIdentity"] = sasl.AuthorizationIdentity.ValueString()
+		options["accessKeyId"] = sasl.AccessKeyID.ValueString()
+		options["secretAccessKey"] = sasl.SecretAccessKey.ValueString()
+
+		if !sasl.SessionToken.IsNull() && sasl.SessionToken.ValueString() != "" {
+			options["sessionToken"] = sasl.SessionToken.ValueString()
+		}
+
+		return options
+	}
+
+	options["username"] = sasl.Username.ValueString()
+	options["password"] = sasl.Password.ValueString()
+
+	return options
+}
+
+// flattenKafkaSASLOptions converts the SASL options returned by Uptime Kuma
+// into the `sasl` object. Null is returned, if SASL is disabled or the
+// mechanism is not known.
+func flattenKafkaSASLOptions(options map[string]any) types.Object {
+	mechanism, _ := options["mechanism"].(string)
+	if !slices.Contains(kafkaSASLMechanisms(), mechanism) {
+		return types.ObjectNull(kafkaSASLAttrTypes())
+	}
+
+	value := func(key string) types.String {
+		s, ok := options[key].(string)
+		if !ok || s == "" {
+			return types.StringNull()
+		}
+
+		return types.StringValue(s)
+	}
+
+	sasl := map[string]attr.Value{
+		"mechanism":              types.StringValue(mechanism),
+		"username":               types.StringNull(),
+		"password":               types.StringNull(),
+		"authorization_identity": types.StringNull(),
+		"access_key_id":          types.StringNull(),
+		"secret_access_key":      types.StringNull(),
+		"session_token":          types.StringNull(),
+	}
+
+	if mechanism == kafkaSASLMechanismAWS {
+		sasl["authorization_identity"] = value("authorizationIdentity")
+		sasl["access_key_id"] = value("accessKeyId")
+		sasl["secret_access_key"] = value("secretAccessKey")
+		sasl["session_token"] = value("sessionToken")
+	} else {
+		sasl["username"] = value("username")
+		sasl["password"] = value("password")
+	}
+
+	return types.ObjectValueMust(kafkaSASLAttrTypes(), sasl)
+}
+
+// kafkaSASLConfigValidator validates, that the attributes of the `sasl`
+// object match the configured mechanism.
+type kafkaSASLConfigValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v kafkaSASLConfigValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (kafkaSASLConfigValidator) MarkdownDescription(_ context.Context) string {
+	return "the attributes of `sasl` must match the configured mechanism"
+}
+
+// ValidateResource validates the SASL configuration.
+func (kafkaSASLConfigValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var obj types.Object
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sasl"), &obj)...)
+	if resp.Diagnostics.HasError() || obj.IsNull() || obj.IsUnknown() {
+		return
+	}
+
+	var sasl MonitorKafkaProducerSASLModel
+
+	resp.Diagnostics.Append(obj.As(ctx, &sasl, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || sasl.Mechanism.IsUnknown() {
+		return
+	}
+
+	mechanism := sasl.Mechanism.ValueString()
+
+	credentials := map[string]types.String{
+		"username": sasl.Username,
+		"password": sasl.Password,
+	}
+	aws := map[string]types.String{
+		"authorization_identity": sasl.AuthorizationIdentity,
+		"access_key_id":          sasl.AccessKeyID,
+		"secret_access_key":      sasl.SecretAccessKey,
+		"session_token":          sasl.SessionToken,
+	}
+
+	required, unsupported := credentials, aws
+	if mechanism == kafkaSASLMechanismAWS {
+		required, unsupported = aws, credentials
+	}
+
+	for _, name := range []string{
+		"username", "password", "authorization_identity", "access_key_id", "secret_access_key", "session_token",
+	} {
+		if value, ok := unsupported[name]; ok && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sasl").AtName(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The attribute %q is not supported by the SASL mechanism %q.", name, mechanism),
+			)
+		}
+
+		// The session token is optional for AWS.
+		if value, ok := required[name]; ok && value.IsNull() && name != "session_token" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sasl").AtName(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("The attribute %q is required by the SASL mechanism %q.", name, mechanism),
+			)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestKafkaSASLOptionsRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		options map[string]any
+	}{
+		{
+			name:    "plain",
+			options: map[string]any{"mechanism": "plain", "username": "user", "password": "pass"},
+		},
+		{
+			name:    "scram-sha-512",
+			options: map[string]any{"mechanism": "scram-sha-512", "username": "user", "password": "pass"},
+		},
+		{
+			name: "aws",
+			options: map[string]any{
+				"mechanism":             "aws",
+				"authorizationIdentity": "AIDAEXAMPLE",
+				"accessKeyId":           "AKIAEXAMPLE",
+				"secretAccessKey":       "secret",
+			},
+		},
+		{
+			name: "aws with session token",
+			options: map[string]any{
+				"mechanism":             "aws",
+				"authorizationIdentity": "AIDAEXAMPLE",
+				"accessKeyId":           "AKIAEXAMPLE",
+				"secretAccessKey":       "secret",
+				"sessionToken":          "token",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sasl := flattenKafkaSASLOptions(tt.options)
+			if sasl.IsNull() {
+				t.Fatal("flattenKafkaSASLOptions() returned null")
+			}
+
+			var diags diag.Diagnostics
+
+			got := buildKafkaSASLOptions(t.Context(), sasl, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !reflect.DeepEqual(got, tt.options) {
+				t.Fatalf("buildKafkaSASLOptions() = %v, want %v", got, tt.options)
+			}
+		})
+	}
+}
+
+func TestFlattenKafkaSASLOptionsDisabled(t *testing.T) {
+	for _, options := range []map[string]any{nil, {"mechanism": "None"}, {"mechanism": "oauthbearer"}} {
+		if sasl := flattenKafkaSASLOptions(options); !sasl.IsNull() {
+			t.Errorf("flattenKafkaSASLOptions(%v) = %s, want null", options, sasl)
+		}
+	}
+}
+
+func TestKafkaSASLConfigValidator(t *testing.T) {
+	saslType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"mechanism":              tftypes.String,
+		"username":               tftypes.String,
+		"password":               tftypes.String,
+		"authorization_identity": tftypes.String,
+		"access_key_id":          tftypes.String,
+		"secret_access_key":      tftypes.String,
+		"session_token":          tftypes.String,
+	}}
+	sasl := func(values map[string]string) tftypes.Value {
+		attrs := make(map[string]tftypes.Value, len(saslType.AttributeTypes))
+		for name := range saslType.AttributeTypes {
+			attrs[name] = tftypes.NewValue(tftypes.String, nil)
+		}
+
+		for name, value := range values {
+			attrs[name] = tftypes.NewValue(tftypes.String, value)
+		}
+
+		return tftypes.NewValue(saslType, attrs)
+	}
+
+	tests := []struct {
+		name    string
+		config  map[string]tftypes.Value
+		wantErr string
+	}{
+		{
+			name: "plain",
+			config: map[string]tftypes.Value{
+				"sasl": sasl(map[string]string{"mechanism": "plain", "username": "user", "password": "pass"}),
+			},
+		},
+		{
+			name: "aws",
+			config: map[string]tftypes.Value{
+				"sasl": sasl(map[string]string{
+					"mechanism":              "aws",
+					"authorization_identity": "AIDAEXAMPLE",
+					"access_key_id":          "AKIAEXAMPLE",
+					"secret_access_key":      "secret",
+				}),
+			},
+		},
+		{
+			name: "unknown mechanism",
+			config: map[string]tftypes.Value{
+				"sasl": sasl(map[string]string{"mechanism": "PLAIN", "username": "user", "password": "pass"}),
+			},
+			wantErr: "Invalid Attribute Value Match",
+		},
+		{
+			name: "missing password",
+			config: map[string]tftypes.Value{
+				"sasl": sasl(map[string]string{"mechanism": "scram-sha-256", "username": "user"}),
+			},
+			wantErr: "Missing Attribute Configuration",
+		},
+		{
+			name: "missing aws secret access key",
+			config: map[string]tftypes.Value{
+				"sasl": sasl(map[string]string{
+					"mechanism":              "aws",
+					"authorization_identity": "AIDAEXAMPLE",
+					"access_key_id":          "AKIAEXAMPLE",
+				}),
+			},
+			wantErr: "Missing Attribute Configuration",
+		},
+		{
+			name: "username with aws",
+			config: map[string]tftypes.Value{
+				"sasl": sasl(map[string]string{
+					"mechanism":              "aws",
+					"username":               "user",
+					"authorization_identity": "AIDAEXAMPLE",
+					"access_key_id":          "AKIAEXAMPLE",
+					"secret_access_key":      "secret",
+				}),
+			},
+			wantErr: "Invalid Attribute Combination",
+		},
+		{
+			name: "sasl and sasl options",
+			config: map[string]tftypes.Value{
+				"sasl":         sasl(map[string]string{"mechanism": "plain", "username": "user", "password": "pass"}),
+				"sasl_options": tftypes.NewValue(tftypes.String, `{"mechanism":"plain"}`),
+			},
+			wantErr: "Invalid Attribute Combination",
+		},
+		{
+			name: "sasl and write-only sasl options",
+			config: map[string]tftypes.Value{
+				"sasl":                    sasl(map[string]string{"mechanism": "plain", "username": "user", "password": "pass"}),
+				"sasl_options_wo":         tftypes.NewValue(tftypes.String, `{"mechanism":"plain"}`),
+				"sasl_options_wo_version": tftypes.NewValue(tftypes.Number, 1),
+			},
+			wantErr: "Invalid Attribute Combination",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]tftypes.Value{
+				"brokers": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "kafka.example.com:9092"),
+				}),
+				"topic":   tftypes.NewValue(tftypes.String, "monitor-topic"),
+				"message": tftypes.NewValue(tftypes.String, "ping"),
+			}
+			maps.Copy(config, tt.config)
+
+			diags := validateResourceConfigValues(t, "uptimekuma_monitor_kafka_producer", config)
+
+			var summaries []string
+			for _, d := range diags {
+				if d.Severity == tfprotov6.DiagnosticSeverityError {
+					summaries = append(summaries, d.Summary+": "+d.Detail)
+				}
+			}
+
+			if tt.wantErr == "" && len(summaries) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", summaries)
+			}
+
+			if tt.wantErr != "" && !hasErrorDiagnostic(diags, tt.wantErr) {
+				t.Fatalf("expected diagnostic %q, got %v", tt.wantErr, summaries)
+			}
+		})
+	}
+}
+
+func TestAccMonitorKafkaProducerResourceWithSASL(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestKafkaProducerMonitorSASL")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorKafkaProducerResourceConfigWithSASL(name, "scram-sha-256"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_kafka_producer.test",
+						tfjsonpath.New("sasl").AtMapKey("mechanism"),
+						knownvalue.StringExact("scram-sha-256"),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_kafka_producer.test",
+						tfjsonpath.New("sasl").AtMapKey("username"),
+						knownvalue.StringExact("user"),
+					),
+				},
+			},
+			{
+				Config: testAccMonitorKafkaProducerResourceConfigWithSASL(name, "scram-sha-512"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_kafka_producer.test",
+						tfjsonpath.New("sasl").AtMapKey("mechanism"),
+						knownvalue.StringExact("scram-sha-512"),
+					),
+				},
+			},
+			{
+				ResourceName:            "uptimekuma_monitor_kafka_producer.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"message"},
+			},
+			{
+				Config:      testAccMonitorKafkaProducerResourceConfigWithSASL(name, "gssapi"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func testAccMonitorKafkaProducerResourceConfigWithSASL(name string, mechanism string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_kafka_producer" "test" {
+  name    = %[1]q
+  brokers = ["kafka.example.com:9092"]
+  topic   = "monitor-topic"
+  message = "ping"
+
+  sasl = {
+    mechanism = %[2]q
+    username  = "user"
+    password  = "pass"
+  }
+}
+`, name, mechanism)
+}
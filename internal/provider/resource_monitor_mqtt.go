@@ -23,6 +23,7 @@ import (
 var (
 	// Ensure MonitorMQTTResource satisfies various resource interfaces.
 	_ resource.Resource                = &MonitorMQTTResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorMQTTResource{}
 	_ resource.ResourceWithImportState = &MonitorMQTTResource{}
 )
 
@@ -33,7 +34,8 @@ func NewMonitorMQTTResource() resource.Resource {
 
 // MonitorMQTTResource defines the resource implementation.
 type MonitorMQTTResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorMQTTResourceModel describes the resource data model for MQTT monitors.
@@ -122,6 +124,15 @@ func (*MonitorMQTTResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorMQTTResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorMQTTResource) Configure(
 	_ context.Context,
@@ -129,6 +140,7 @@ func (r *MonitorMQTTResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -151,7 +163,7 @@ func (r *MonitorMQTTResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	data.ID = types.Int64Value(id)
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -389,7 +401,15 @@ func (r *MonitorMQTTResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -17,8 +17,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &MonitorMySQLResource{}
-	_ resource.ResourceWithImportState = &MonitorMySQLResource{}
+	_ resource.Resource                     = &MonitorMySQLResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorMySQLResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorMySQLResource{}
+	_ resource.ResourceWithImportState      = &MonitorMySQLResource{}
 )
 
 // NewMonitorMySQLResource returns a new instance of the MySQL monitor resource.
@@ -28,12 +30,14 @@ func NewMonitorMySQLResource() resource.Resource {
 
 // MonitorMySQLResource defines the resource implementation.
 type MonitorMySQLResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorMySQLResourceModel describes the resource data model.
 type MonitorMySQLResourceModel struct {
 	MonitorBaseModel
+	MonitorDatabaseConnectionModel
 
 	DatabaseConnectionString types.String `tfsdk:"database_connection_string"`
 	DatabaseQuery            types.String `tfsdk:"database_query"`
@@ -57,11 +61,12 @@ func (*MonitorMySQLResource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "MySQL monitor resource",
-		Attributes: withMonitorBaseAttributes(map[string]schema.Attribute{
+		Attributes: withMonitorBaseAttributes(withDatabaseConnectionAttributes(map[string]schema.Attribute{
 			"database_connection_string": schema.StringAttribute{
-				MarkdownDescription: "MySQL connection string (e.g., user:password@tcp(host:port)/database)",
-				Required:            true,
-				Sensitive:           true,
+				MarkdownDescription: "MySQL connection string (e.g., user:password@tcp(host:port)/database). " +
+					"Conflicts with the structured `database_*` attributes",
+				Optional:  true,
+				Sensitive: true,
 			},
 			"database_query": schema.StringAttribute{
 				MarkdownDescription: "SQL query to execute for health check",
@@ -70,10 +75,24 @@ func (*MonitorMySQLResource) Schema(
 				Default:             stringdefault.StaticString("SELECT 1"),
 			},
 			"conditions": conditionsAttribute(),
-		}),
+		}, mysqlDatabaseDriver())),
 	}
 }
 
+// ConfigValidators returns the config validators for the resource.
+func (*MonitorMySQLResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return databaseConnectionConfigValidators()
+}
+
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorMySQLResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the MySQL monitor resource with the API client.
 func (r *MonitorMySQLResource) Configure(
 	_ context.Context,
@@ -81,6 +100,7 @@ func (r *MonitorMySQLResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new MySQL monitor resource.
@@ -109,9 +129,15 @@ func (r *MonitorMySQLResource) Create(
 			IsActive:       data.Active.ValueBool(),
 		},
 		MySQLDetails: monitor.MySQLDetails{
-			DatabaseConnectionString: data.DatabaseConnectionString.ValueString(),
-			DatabaseQuery:            &databaseQuery,
-			Conditions:               buildConditions(ctx, data.Conditions, &resp.Diagnostics),
+			DatabaseConnectionString: buildDatabaseConnectionString(
+				ctx,
+				mysqlDatabaseDriver(),
+				data.DatabaseConnectionString,
+				&data.MonitorDatabaseConnectionModel,
+				&resp.Diagnostics,
+			),
+			DatabaseQuery: &databaseQuery,
+			Conditions:    buildConditions(ctx, data.Conditions, &resp.Diagnostics),
 		},
 	}
 
@@ -148,7 +174,7 @@ func (r *MonitorMySQLResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -210,7 +236,14 @@ func (r *MonitorMySQLResource) Read(ctx context.Context, req resource.ReadReques
 	data.MaxRetries = types.Int64Value(mysqlMonitor.MaxRetries)
 	data.UpsideDown = types.BoolValue(mysqlMonitor.UpsideDown)
 	data.Active = types.BoolValue(mysqlMonitor.IsActive)
-	data.DatabaseConnectionString = types.StringValue(mysqlMonitor.DatabaseConnectionString)
+	populateDatabaseConnectionString(
+		ctx,
+		mysqlDatabaseDriver(),
+		mysqlMonitor.DatabaseConnectionString,
+		&data.DatabaseConnectionString,
+		&data.MonitorDatabaseConnectionModel,
+		&resp.Diagnostics,
+	)
 	if mysqlMonitor.DatabaseQuery != nil {
 		data.DatabaseQuery = types.StringValue(*mysqlMonitor.DatabaseQuery)
 	} else {
@@ -284,9 +317,15 @@ func (r *MonitorMySQLResource) Update(
 			IsActive:       data.Active.ValueBool(),
 		},
 		MySQLDetails: monitor.MySQLDetails{
-			DatabaseConnectionString: data.DatabaseConnectionString.ValueString(),
-			DatabaseQuery:            &databaseQuery,
-			Conditions:               buildConditions(ctx, data.Conditions, &resp.Diagnostics),
+			DatabaseConnectionString: buildDatabaseConnectionString(
+				ctx,
+				mysqlDatabaseDriver(),
+				data.DatabaseConnectionString,
+				&data.MonitorDatabaseConnectionModel,
+				&resp.Diagnostics,
+			),
+			DatabaseQuery: &databaseQuery,
+			Conditions:    buildConditions(ctx, data.Conditions, &resp.Diagnostics),
 		},
 	}
 
@@ -321,7 +360,15 @@ func (r *MonitorMySQLResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
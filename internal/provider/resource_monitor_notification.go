@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &MonitorNotificationResource{}
+	_ resource.ResourceWithImportState = &MonitorNotificationResource{}
+)
+
+// NewMonitorNotificationResource returns a new instance of the MonitorNotification resource.
+func NewMonitorNotificationResource() resource.Resource {
+	return &MonitorNotificationResource{}
+}
+
+// MonitorNotificationResource defines the resource implementation.
+type MonitorNotificationResource struct {
+	providerData *providerData
+}
+
+// MonitorNotificationResourceModel describes the MonitorNotification resource data model.
+type MonitorNotificationResourceModel struct {
+	MonitorID      types.Int64 `tfsdk:"monitor_id"`
+	NotificationID types.Int64 `tfsdk:"notification_id"`
+}
+
+// Metadata returns the metadata for the resource.
+func (*MonitorNotificationResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_notification"
+}
+
+// Schema returns the schema for the resource.
+func (*MonitorNotificationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attach a notification to a monitor. Other notifications of the monitor are left " +
+			"untouched. The monitor must not manage the notification in its `notification_ids` attribute.",
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.Int64Attribute{
+				MarkdownDescription: "Monitor ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"notification_id": schema.Int64Attribute{
+				MarkdownDescription: "Notification ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the API client.
+func (r *MonitorNotificationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	r.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates a new resource.
+func (r *MonitorNotificationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data MonitorNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.providerData.updateMonitorNotifications(
+		ctx,
+		data.MonitorID.ValueInt64(),
+		[]int64{data.NotificationID.ValueInt64()},
+		nil,
+	)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError("failed to add monitor notification", err.Error())
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the current state of the resource.
+func (r *MonitorNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MonitorNotificationResourceModel
+
+	// Get resource from state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mon, err := r.providerData.client.GetMonitor(ctx, data.MonitorID.ValueInt64())
+	// Handle error.
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to read monitor notification", err.Error())
+		return
+	}
+
+	if !slices.Contains(mon.NotificationIDs, data.NotificationID.ValueInt64()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource. All attributes require a replacement, so
+// there is nothing to update on the server.
+func (*MonitorNotificationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data MonitorNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource.
+func (r *MonitorNotificationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data MonitorNotificationResourceModel
+
+	// Get resource from state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.providerData.updateMonitorNotifications(
+		ctx,
+		data.MonitorID.ValueInt64(),
+		nil,
+		[]int64{data.NotificationID.ValueInt64()},
+	)
+	// Handle error.
+	if err != nil {
+		if isNotFoundError(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to delete monitor notification", err.Error())
+		return
+	}
+}
+
+// ImportState imports an existing resource by `<monitor_id>/<notification_id>`.
+func (*MonitorNotificationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	monitorID, notificationID, err := parseMonitorNotificationID(req.ID)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in the format <monitor_id>/<notification_id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_id"), monitorID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("notification_id"), notificationID)...)
+}
+
+// parseMonitorNotificationID parses an import ID in the format
+// `<monitor_id>/<notification_id>`.
+func parseMonitorNotificationID(id string) (int64, int64, error) {
+	monitor, notification, ok := strings.Cut(id, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("missing separator in %q", id)
+	}
+
+	monitorID, err := strconv.ParseInt(monitor, 10, 64)
+	// Handle error.
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid monitor ID: %w", err)
+	}
+
+	notificationID, err := strconv.ParseInt(notification, 10, 64)
+	// Handle error.
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid notification ID: %w", err)
+	}
+
+	return monitorID, notificationID, nil
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestParseMonitorNotificationID(t *testing.T) {
+	tests := []struct {
+		id                 string
+		wantMonitorID      int64
+		wantNotificationID int64
+		wantErr            bool
+	}{
+		{id: "12/3", wantMonitorID: 12, wantNotificationID: 3},
+		{id: "12", wantErr: true},
+		{id: "a/3", wantErr: true},
+		{id: "12/b", wantErr: true},
+		{id: "12/3/4", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			monitorID, notificationID, err := parseMonitorNotificationID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMonitorNotificationID(%q) error = %v, want error %t", tt.id, err, tt.wantErr)
+			}
+
+			if monitorID != tt.wantMonitorID || notificationID != tt.wantNotificationID {
+				t.Fatalf(
+					"parseMonitorNotificationID(%q) = %d, %d, want %d, %d",
+					tt.id, monitorID, notificationID, tt.wantMonitorID, tt.wantNotificationID,
+				)
+			}
+		})
+	}
+}
+
+func TestUseStateForUnconfigured(t *testing.T) {
+	ids := func(values ...int64) types.List {
+		elements := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elements = append(elements, types.Int64Value(v))
+		}
+
+		return types.ListValueMust(types.Int64Type, elements)
+	}
+
+	tests := []struct {
+		name   string
+		config types.List
+		state  types.List
+		want   types.List
+	}{
+		{
+			name:   "create without config",
+			config: types.ListNull(types.Int64Type),
+			state:  types.ListNull(types.Int64Type),
+			want:   types.ListNull(types.Int64Type),
+		},
+		{
+			name:   "update without config",
+			config: types.ListNull(types.Int64Type),
+			state:  ids(1, 2),
+			want:   ids(1, 2),
+		},
+		{
+			name:   "configured",
+			config: ids(3),
+			state:  ids(1, 2),
+			want:   ids(3),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.ListRequest{
+				ConfigValue: tt.config,
+				StateValue:  tt.state,
+				PlanValue:   types.ListUnknown(types.Int64Type),
+			}
+			if !tt.config.IsNull() {
+				req.PlanValue = tt.config
+			}
+
+			resp := &planmodifier.ListResponse{PlanValue: req.PlanValue}
+
+			useStateForUnconfigured{}.PlanModifyList(t.Context(), req, resp)
+
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Fatalf("plan = %s, want %s", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccMonitorNotificationResource(t *testing.T) {
+	monitorName := acctest.RandomWithPrefix("TestMonitorNotification")
+	notificationName := acctest.RandomWithPrefix("TestMonitorNotificationWebhook")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorNotificationResourceConfig(monitorName, notificationName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"uptimekuma_monitor_notification.test",
+						tfjsonpath.New("notification_id"),
+						"uptimekuma_notification_webhook.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			{
+				// The monitor does not manage its notifications, so the
+				// association must not cause a diff on the monitor.
+				Config: testAccMonitorNotificationResourceConfig(monitorName, notificationName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("notification_ids"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+			{
+				ResourceName:                         "uptimekuma_monitor_notification.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "monitor_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["uptimekuma_monitor_notification.test"]
+					return rs.Primary.Attributes["monitor_id"] + "/" + rs.Primary.Attributes["notification_id"], nil
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorNotificationResourceConfig(monitorName string, notificationName string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_notification_webhook" "test" {
+  name                 = %[2]q
+  webhook_url          = "https://example.com/webhook"
+  webhook_content_type = "json"
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  name = %[1]q
+  url  = "https://example.com"
+}
+
+resource "uptimekuma_monitor_notification" "test" {
+  monitor_id      = uptimekuma_monitor_http.test.id
+  notification_id = uptimekuma_notification_webhook.test.id
+}
+`, monitorName, notificationName)
+}
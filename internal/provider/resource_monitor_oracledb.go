@@ -17,8 +17,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &MonitorOracleDBResource{}
-	_ resource.ResourceWithImportState = &MonitorOracleDBResource{}
+	_ resource.Resource                     = &MonitorOracleDBResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorOracleDBResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorOracleDBResource{}
+	_ resource.ResourceWithImportState      = &MonitorOracleDBResource{}
 )
 
 // NewMonitorOracleDBResource returns a new instance of the OracleDB monitor resource.
@@ -28,12 +30,14 @@ func NewMonitorOracleDBResource() resource.Resource {
 
 // MonitorOracleDBResource defines the resource implementation.
 type MonitorOracleDBResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorOracleDBResourceModel describes the resource data model.
 type MonitorOracleDBResourceModel struct {
 	MonitorBaseModel
+	MonitorDatabaseAddressModel
 
 	DatabaseConnectionString types.String `tfsdk:"database_connection_string"`
 	DatabaseQuery            types.String `tfsdk:"database_query"`
@@ -59,11 +63,12 @@ func (*MonitorOracleDBResource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "OracleDB monitor resource",
-		Attributes: withMonitorBaseAttributes(map[string]schema.Attribute{
+		Attributes: withMonitorBaseAttributes(withDatabaseConnectionAttributes(map[string]schema.Attribute{
 			"database_connection_string": schema.StringAttribute{
-				MarkdownDescription: "Oracle EZCONNECT connection string (e.g., host:port/service)",
-				Required:            true,
-				Sensitive:           true,
+				MarkdownDescription: "Oracle EZCONNECT connection string (e.g., host:port/service). " +
+					"Conflicts with the structured `database_*` attributes",
+				Optional:  true,
+				Sensitive: true,
 			},
 			"database_query": schema.StringAttribute{
 				MarkdownDescription: "SQL query to execute for health check",
@@ -85,10 +90,24 @@ func (*MonitorOracleDBResource) Schema(
 				Default:             stringdefault.StaticString(""),
 			},
 			"conditions": conditionsAttribute(),
-		}),
+		}, oracleDBDatabaseDriver())),
 	}
 }
 
+// ConfigValidators returns the config validators for the resource.
+func (*MonitorOracleDBResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return databaseConnectionConfigValidators()
+}
+
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorOracleDBResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the OracleDB monitor resource with the API client.
 func (r *MonitorOracleDBResource) Configure(
 	_ context.Context,
@@ -96,6 +115,7 @@ func (r *MonitorOracleDBResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new OracleDB monitor resource.
@@ -123,11 +143,17 @@ func (r *MonitorOracleDBResource) Create(
 			IsActive:       data.Active.ValueBool(),
 		},
 		OracleDBDetails: monitor.OracleDBDetails{
-			DatabaseConnectionString: data.DatabaseConnectionString.ValueString(),
-			DatabaseQuery:            data.DatabaseQuery.ValueStringPointer(),
-			Username:                 data.BasicAuthUser.ValueString(),
-			Password:                 data.BasicAuthPass.ValueString(),
-			Conditions:               buildConditions(ctx, data.Conditions, &resp.Diagnostics),
+			DatabaseConnectionString: buildDatabaseConnectionString(
+				ctx,
+				oracleDBDatabaseDriver(),
+				data.DatabaseConnectionString,
+				&data.MonitorDatabaseAddressModel,
+				&resp.Diagnostics,
+			),
+			DatabaseQuery: data.DatabaseQuery.ValueStringPointer(),
+			Username:      data.BasicAuthUser.ValueString(),
+			Password:      data.BasicAuthPass.ValueString(),
+			Conditions:    buildConditions(ctx, data.Conditions, &resp.Diagnostics),
 		},
 	}
 
@@ -164,7 +190,7 @@ func (r *MonitorOracleDBResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -226,7 +252,14 @@ func (r *MonitorOracleDBResource) Read(ctx context.Context, req resource.ReadReq
 	data.MaxRetries = types.Int64Value(oracleDBMonitor.MaxRetries)
 	data.UpsideDown = types.BoolValue(oracleDBMonitor.UpsideDown)
 	data.Active = types.BoolValue(oracleDBMonitor.IsActive)
-	data.DatabaseConnectionString = types.StringValue(oracleDBMonitor.DatabaseConnectionString)
+	populateDatabaseConnectionString(
+		ctx,
+		oracleDBDatabaseDriver(),
+		oracleDBMonitor.DatabaseConnectionString,
+		&data.DatabaseConnectionString,
+		&data.MonitorDatabaseAddressModel,
+		&resp.Diagnostics,
+	)
 	if oracleDBMonitor.DatabaseQuery != nil {
 		data.DatabaseQuery = types.StringValue(*oracleDBMonitor.DatabaseQuery)
 	}
@@ -299,11 +332,17 @@ func (r *MonitorOracleDBResource) Update(
 			IsActive:       data.Active.ValueBool(),
 		},
 		OracleDBDetails: monitor.OracleDBDetails{
-			DatabaseConnectionString: data.DatabaseConnectionString.ValueString(),
-			DatabaseQuery:            data.DatabaseQuery.ValueStringPointer(),
-			Username:                 data.BasicAuthUser.ValueString(),
-			Password:                 data.BasicAuthPass.ValueString(),
-			Conditions:               buildConditions(ctx, data.Conditions, &resp.Diagnostics),
+			DatabaseConnectionString: buildDatabaseConnectionString(
+				ctx,
+				oracleDBDatabaseDriver(),
+				data.DatabaseConnectionString,
+				&data.MonitorDatabaseAddressModel,
+				&resp.Diagnostics,
+			),
+			DatabaseQuery: data.DatabaseQuery.ValueStringPointer(),
+			Username:      data.BasicAuthUser.ValueString(),
+			Password:      data.BasicAuthPass.ValueString(),
+			Conditions:    buildConditions(ctx, data.Conditions, &resp.Diagnostics),
 		},
 	}
 
@@ -338,7 +377,15 @@ func (r *MonitorOracleDBResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
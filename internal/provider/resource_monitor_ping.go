@@ -20,6 +20,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorPingResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorPingResource{}
 	_ resource.ResourceWithImportState = &MonitorPingResource{}
 )
 
@@ -30,7 +31,8 @@ func NewMonitorPingResource() resource.Resource {
 
 // MonitorPingResource defines the resource implementation.
 type MonitorPingResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorPingResourceModel describes the resource data model.
@@ -84,6 +86,15 @@ func (*MonitorPingResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorPingResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the Ping monitor resource with the API client.
 func (r *MonitorPingResource) Configure(
 	_ context.Context,
@@ -91,6 +102,7 @@ func (r *MonitorPingResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new Ping monitor resource.
@@ -154,7 +166,7 @@ func (r *MonitorPingResource) Create(ctx context.Context, req resource.CreateReq
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -321,7 +333,15 @@ func (r *MonitorPingResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
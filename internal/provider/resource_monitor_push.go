@@ -49,6 +49,7 @@ func generatePushToken() (string, error) {
 
 var (
 	_ resource.Resource                = &MonitorPushResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorPushResource{}
 	_ resource.ResourceWithImportState = &MonitorPushResource{}
 )
 
@@ -59,7 +60,8 @@ func NewMonitorPushResource() resource.Resource {
 
 // MonitorPushResource defines the resource implementation.
 type MonitorPushResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorPushResourceModel describes the resource data model.
@@ -84,7 +86,7 @@ func (*MonitorPushResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		MarkdownDescription: "Push monitor resource",
 		Attributes: withMonitorBaseAttributes(map[string]schema.Attribute{
 			"push_token": schema.StringAttribute{
-				MarkdownDescription: "Unique push token generated during resource creation. Used to construct the push URL: `{baseURL}/api/push/{pushToken}?status=up&msg=OK&ping=`.",
+				MarkdownDescription: "Unique push token generated during resource creation. Used to construct the push URL: `{baseURL}/api/push/{pushToken}?status=up&msg=OK&ping=`. The `provider::uptimekuma::push_url` function builds this URL with proper escaping.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -94,6 +96,15 @@ func (*MonitorPushResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorPushResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the Push monitor resource with the API client.
 func (r *MonitorPushResource) Configure(
 	_ context.Context,
@@ -101,6 +112,7 @@ func (r *MonitorPushResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new Push monitor resource.
@@ -167,7 +179,7 @@ func (r *MonitorPushResource) Create(ctx context.Context, req resource.CreateReq
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -329,7 +341,15 @@ func (r *MonitorPushResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -20,6 +20,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorRabbitMQResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorRabbitMQResource{}
 	_ resource.ResourceWithImportState = &MonitorRabbitMQResource{}
 )
 
@@ -30,17 +31,18 @@ func NewMonitorRabbitMQResource() resource.Resource {
 
 // MonitorRabbitMQResource defines the resource implementation.
 type MonitorRabbitMQResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorRabbitMQResourceModel describes the resource data model.
 type MonitorRabbitMQResourceModel struct {
 	MonitorBaseModel
 
-	Nodes    types.String `tfsdk:"nodes"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Timeout  types.Int64  `tfsdk:"timeout"`
+	Nodes    NormalizedJSONValue `tfsdk:"nodes"`
+	Username types.String        `tfsdk:"username"`
+	Password types.String        `tfsdk:"password"`
+	Timeout  types.Int64         `tfsdk:"timeout"`
 }
 
 // Metadata returns the metadata for the resource.
@@ -64,7 +66,11 @@ func (*MonitorRabbitMQResource) Schema(
 			"nodes": schema.StringAttribute{
 				MarkdownDescription: "JSON-encoded array of RabbitMQ management API node URLs " +
 					"(e.g., `[\"http://rabbitmq.example.com:15672/\"]`)",
-				Required: true,
+				CustomType: NormalizedJSONType{},
+				Required:   true,
+				Validators: []validator.String{
+					validateJSON(),
+				},
 			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "Username for HTTP Basic authentication against the RabbitMQ management API",
@@ -88,6 +94,15 @@ func (*MonitorRabbitMQResource) Schema(
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorRabbitMQResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the RabbitMQ monitor resource with the API client.
 func (r *MonitorRabbitMQResource) Configure(
 	_ context.Context,
@@ -95,6 +110,7 @@ func (r *MonitorRabbitMQResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new RabbitMQ monitor resource.
@@ -158,7 +174,7 @@ func (r *MonitorRabbitMQResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -224,7 +240,7 @@ func (r *MonitorRabbitMQResource) Read(
 	data.MaxRetries = types.Int64Value(rabbitMQMonitor.MaxRetries)
 	data.UpsideDown = types.BoolValue(rabbitMQMonitor.UpsideDown)
 	data.Active = types.BoolValue(rabbitMQMonitor.IsActive)
-	data.Nodes = types.StringValue(rabbitMQMonitor.Nodes)
+	data.Nodes = NewNormalizedJSONValue(rabbitMQMonitor.Nodes)
 	data.Username = ptrToTypes(rabbitMQMonitor.Username)
 	data.Password = ptrToTypes(rabbitMQMonitor.Password)
 
@@ -328,7 +344,15 @@ func (r *MonitorRabbitMQResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -22,6 +22,7 @@ import (
 var (
 	// Ensure MonitorRadiusResource satisfies various resource interfaces.
 	_ resource.Resource                = &MonitorRadiusResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorRadiusResource{}
 	_ resource.ResourceWithImportState = &MonitorRadiusResource{}
 )
 
@@ -32,7 +33,8 @@ func NewMonitorRadiusResource() resource.Resource {
 
 // MonitorRadiusResource defines the resource implementation.
 type MonitorRadiusResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorRadiusResourceModel describes the resource data model for Radius monitors.
@@ -103,6 +105,15 @@ func (*MonitorRadiusResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorRadiusResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorRadiusResource) Configure(
 	_ context.Context,
@@ -110,6 +121,7 @@ func (r *MonitorRadiusResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new Radius monitor resource.
@@ -137,7 +149,7 @@ func (r *MonitorRadiusResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -347,7 +359,15 @@ func (r *MonitorRadiusResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -26,6 +26,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorRealBrowserResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorRealBrowserResource{}
 	_ resource.ResourceWithImportState = &MonitorRealBrowserResource{}
 )
 
@@ -36,7 +37,8 @@ func NewMonitorRealBrowserResource() resource.Resource {
 
 // MonitorRealBrowserResource defines the resource implementation.
 type MonitorRealBrowserResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorRealBrowserResourceModel describes the resource data model.
@@ -128,8 +130,9 @@ func withRealBrowserMonitorAttributes(attrs map[string]schema.Attribute) map[str
 	}
 
 	attrs["remote_browser"] = schema.Int64Attribute{
-		MarkdownDescription: "Remote Browser ID (if using a remote browser for monitoring)",
-		Optional:            true,
+		MarkdownDescription: "Remote Browser ID (if using a remote browser for monitoring), " +
+			"e.g. of an `uptimekuma_remote_browser`",
+		Optional: true,
 	}
 
 	attrs["screenshot_delay"] = schema.Int64Attribute{
@@ -145,6 +148,15 @@ func withRealBrowserMonitorAttributes(attrs map[string]schema.Attribute) map[str
 	return attrs
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorRealBrowserResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the Real Browser monitor resource with the API client.
 func (r *MonitorRealBrowserResource) Configure(
 	_ context.Context,
@@ -152,6 +164,7 @@ func (r *MonitorRealBrowserResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // buildRealBrowserMonitor constructs a Real Browser monitor from the resource model.
@@ -261,7 +274,7 @@ func (r *MonitorRealBrowserResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -432,7 +445,15 @@ func (r *MonitorRealBrowserResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+// groupMonitorType is the type of group monitors, the only monitors which
+// can be used as `parent`.
+const groupMonitorType = "group"
+
+// modifyMonitorPlan validates the references of a planned monitor to other
+// objects in Uptime Kuma: `notification_ids`, `parent`, `proxy_id`,
+// `docker_host_id` and the `tag_id` of `tags`. References, which are not
+// known yet, are skipped, as well as attributes not present in the schema of
+// the monitor. This surfaces typos at plan time instead of failing in the
+// middle of creating the monitor.
+func modifyMonitorPlan(
+	ctx context.Context,
+	client *kuma.Client,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to validate on destroy or before the provider is configured.
+	if client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	attrs := req.Plan.Schema.GetAttributes()
+
+	if _, ok := attrs["notification_ids"]; ok {
+		validateMonitorNotificationReferences(ctx, client, req.Plan, &resp.Diagnostics)
+	}
+
+	if _, ok := attrs["parent"]; ok {
+		validateMonitorParentReference(ctx, client, req, &resp.Diagnostics)
+	}
+
+	if _, ok := attrs["proxy_id"]; ok {
+		validateMonitorProxyReference(ctx, client, req.Plan, &resp.Diagnostics)
+	}
+
+	if _, ok := attrs["docker_host_id"]; ok {
+		validateMonitorDockerHostReference(ctx, client, req.Plan, &resp.Diagnostics)
+	}
+
+	if _, ok := attrs["tags"]; ok {
+		validateMonitorTagReferences(ctx, client, req.Plan, &resp.Diagnostics)
+	}
+}
+
+func validateMonitorNotificationReferences(
+	ctx context.Context,
+	client *kuma.Client,
+	plan tfsdk.Plan,
+	diags *diag.Diagnostics,
+) {
+	var notificationIDs types.List
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("notification_ids"), &notificationIDs)...)
+	if diags.HasError() || notificationIDs.IsNull() || notificationIDs.IsUnknown() {
+		return
+	}
+
+	existing := map[int64]bool{}
+	for _, n := range client.GetNotifications(ctx) {
+		existing[n.ID] = true
+	}
+
+	for i, elem := range notificationIDs.Elements() {
+		id, ok := elem.(types.Int64)
+		if !ok || id.IsNull() || id.IsUnknown() || existing[id.ValueInt64()] {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root("notification_ids").AtListIndex(i),
+			"Unknown Notification",
+			fmt.Sprintf("Notification with ID %d does not exist.", id.ValueInt64()),
+		)
+	}
+}
+
+func validateMonitorParentReference(
+	ctx context.Context,
+	client *kuma.Client,
+	req resource.ModifyPlanRequest,
+	diags *diag.Diagnostics,
+) {
+	var parent, id types.Int64
+
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("parent"), &parent)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	if diags.HasError() || parent.IsNull() || parent.IsUnknown() {
+		return
+	}
+
+	if !id.IsNull() && !id.IsUnknown() && id.ValueInt64() == parent.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("parent"),
+			"Invalid Parent Monitor",
+			"A monitor cannot be its own parent.",
+		)
+
+		return
+	}
+
+	monitors, err := client.GetMonitors(ctx)
+	// Handle error.
+	if err != nil {
+		diags.AddError("failed to read monitors", err.Error())
+		return
+	}
+
+	for _, m := range monitors {
+		if m.ID != parent.ValueInt64() {
+			continue
+		}
+
+		if m.Type() != groupMonitorType {
+			diags.AddAttributeError(
+				path.Root("parent"),
+				"Invalid Parent Monitor",
+				fmt.Sprintf(
+					"Monitor %d (%q) is of type %q, only monitors of type %q can be used as parent.",
+					m.ID, m.Name, m.Type(), groupMonitorType,
+				),
+			)
+		}
+
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("parent"),
+		"Unknown Parent Monitor",
+		fmt.Sprintf("Monitor with ID %d does not exist.", parent.ValueInt64()),
+	)
+}
+
+func validateMonitorProxyReference(ctx context.Context, client *kuma.Client, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	var proxyID types.Int64
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("proxy_id"), &proxyID)...)
+	if diags.HasError() || proxyID.IsNull() || proxyID.IsUnknown() {
+		return
+	}
+
+	p, err := client.GetProxy(ctx, proxyID.ValueInt64())
+	// Handle error.
+	if err != nil {
+		if isNotFoundError(err) {
+			diags.AddAttributeError(
+				path.Root("proxy_id"),
+				"Unknown Proxy",
+				fmt.Sprintf("Proxy with ID %d does not exist.", proxyID.ValueInt64()),
+			)
+
+			return
+		}
+
+		diags.AddError("failed to read proxy", err.Error())
+
+		return
+	}
+
+	if !p.Active {
+		diags.AddAttributeError(
+			path.Root("proxy_id"),
+			"Inactive Proxy",
+			fmt.Sprintf("Proxy with ID %d (%s:%d) is not active.", p.ID, p.Host, p.Port),
+		)
+	}
+}
+
+func validateMonitorDockerHostReference(
+	ctx context.Context,
+	client *kuma.Client,
+	plan tfsdk.Plan,
+	diags *diag.Diagnostics,
+) {
+	var dockerHostID types.Int64
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("docker_host_id"), &dockerHostID)...)
+	if diags.HasError() || dockerHostID.IsNull() || dockerHostID.IsUnknown() {
+		return
+	}
+
+	_, err := client.GetDockerHost(ctx, dockerHostID.ValueInt64())
+	// Handle error.
+	if err != nil {
+		if isNotFoundError(err) {
+			diags.AddAttributeError(
+				path.Root("docker_host_id"),
+				"Unknown Docker Host",
+				fmt.Sprintf("Docker host with ID %d does not exist.", dockerHostID.ValueInt64()),
+			)
+
+			return
+		}
+
+		diags.AddError("failed to read docker host", err.Error())
+	}
+}
+
+func validateMonitorTagReferences(ctx context.Context, client *kuma.Client, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	var tags types.Set
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if diags.HasError() || tags.IsNull() || tags.IsUnknown() {
+		return
+	}
+
+	var existing map[int64]bool
+
+	for _, elem := range tags.Elements() {
+		tagValue, ok := elem.(types.Object)
+		if !ok || tagValue.IsNull() || tagValue.IsUnknown() {
+			continue
+		}
+
+		tagID, ok := tagValue.Attributes()["tag_id"].(types.Int64)
+		if !ok || tagID.IsNull() || tagID.IsUnknown() {
+			continue
+		}
+
+		// Fetch the tags once, only if there is a known tag ID to validate.
+		if existing == nil {
+			tagList, err := client.GetTags(ctx)
+			// Handle error.
+			if err != nil {
+				diags.AddError("failed to read tags", err.Error())
+				return
+			}
+
+			existing = make(map[int64]bool, len(tagList))
+			for _, t := range tagList {
+				existing[t.ID] = true
+			}
+		}
+
+		if !existing[tagID.ValueInt64()] {
+			diags.AddAttributeError(
+				path.Root("tags").AtSetValue(elem).AtName("tag_id"),
+				"Unknown Tag",
+				fmt.Sprintf("Tag with ID %d does not exist.", tagID.ValueInt64()),
+			)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMonitorReferences(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestMonitorReferences")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create the referenced monitors first, so their IDs are known at plan time.
+				Config: testAccMonitorReferencesConfig(name, ""),
+			},
+			{
+				Config:      testAccMonitorReferencesConfig(name, `notification_ids = [999999]`),
+				ExpectError: regexp.MustCompile(`Notification with ID 999999 does not exist`),
+			},
+			{
+				Config:      testAccMonitorReferencesConfig(name, `parent = 999999`),
+				ExpectError: regexp.MustCompile(`Monitor with ID 999999 does not exist`),
+			},
+			{
+				Config:      testAccMonitorReferencesConfig(name, `parent = uptimekuma_monitor_http.existing.id`),
+				ExpectError: regexp.MustCompile(`only monitors of type "group" can be used as parent`),
+			},
+			{
+				Config:      testAccMonitorReferencesConfig(name, `proxy_id = 999999`),
+				ExpectError: regexp.MustCompile(`Proxy with ID 999999 does not exist`),
+			},
+			{
+				Config:      testAccMonitorReferencesConfig(name, `tags = [{ tag_id = 999999 }]`),
+				ExpectError: regexp.MustCompile(`Tag with ID 999999 does not exist`),
+			},
+			{
+				Config: testAccMonitorReferencesConfig(name, `parent = uptimekuma_monitor_group.existing.id`),
+			},
+		},
+	})
+}
+
+func testAccMonitorReferencesConfig(name string, reference string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_group" "existing" {
+  name = "%[1]s-group"
+}
+
+resource "uptimekuma_monitor_http" "existing" {
+  name = "%[1]s-existing"
+  url  = "https://httpbin.org/status/200"
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  name = %[1]q
+  url  = "https://httpbin.org/status/200"
+
+  %[2]s
+}
+`, name, reference)
+}
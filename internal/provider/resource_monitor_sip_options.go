@@ -20,6 +20,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorSIPOptionsResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorSIPOptionsResource{}
 	_ resource.ResourceWithImportState = &MonitorSIPOptionsResource{}
 )
 
@@ -30,7 +31,8 @@ func NewMonitorSIPOptionsResource() resource.Resource {
 
 // MonitorSIPOptionsResource defines the resource implementation.
 type MonitorSIPOptionsResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorSIPOptionsResourceModel describes the resource data model.
@@ -80,6 +82,15 @@ func (*MonitorSIPOptionsResource) Schema(
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorSIPOptionsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the SIP Options monitor resource with the API client.
 func (r *MonitorSIPOptionsResource) Configure(
 	_ context.Context,
@@ -87,6 +98,7 @@ func (r *MonitorSIPOptionsResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new SIP Options monitor resource.
@@ -149,7 +161,7 @@ func (r *MonitorSIPOptionsResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -310,7 +322,15 @@ func (r *MonitorSIPOptionsResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -24,6 +24,7 @@ import (
 var (
 	// Ensure MonitorSMTPResource satisfies various resource interfaces.
 	_ resource.Resource                = &MonitorSMTPResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorSMTPResource{}
 	_ resource.ResourceWithImportState = &MonitorSMTPResource{}
 )
 
@@ -34,7 +35,8 @@ func NewMonitorSMTPResource() resource.Resource {
 
 // MonitorSMTPResource defines the resource implementation.
 type MonitorSMTPResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorSMTPResourceModel describes the resource data model for SMTP monitors.
@@ -88,6 +90,15 @@ func (*MonitorSMTPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorSMTPResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorSMTPResource) Configure(
 	_ context.Context,
@@ -95,6 +106,7 @@ func (r *MonitorSMTPResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -117,7 +129,7 @@ func (r *MonitorSMTPResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	data.ID = types.Int64Value(id)
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -306,7 +318,15 @@ func (r *MonitorSMTPResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -23,6 +23,7 @@ import (
 var (
 	// Ensure MonitorSNMPResource satisfies various resource interfaces.
 	_ resource.Resource                = &MonitorSNMPResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorSNMPResource{}
 	_ resource.ResourceWithImportState = &MonitorSNMPResource{}
 )
 
@@ -33,7 +34,8 @@ func NewMonitorSNMPResource() resource.Resource {
 
 // MonitorSNMPResource defines the resource implementation.
 type MonitorSNMPResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorSNMPResourceModel describes the resource data model for SNMP monitors.
@@ -121,6 +123,15 @@ func (*MonitorSNMPResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorSNMPResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorSNMPResource) Configure(
 	_ context.Context,
@@ -128,6 +139,7 @@ func (r *MonitorSNMPResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -150,7 +162,7 @@ func (r *MonitorSNMPResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	data.ID = types.Int64Value(id)
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -373,7 +385,15 @@ func (r *MonitorSNMPResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
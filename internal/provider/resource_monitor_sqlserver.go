@@ -17,8 +17,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &MonitorSQLServerResource{}
-	_ resource.ResourceWithImportState = &MonitorSQLServerResource{}
+	_ resource.Resource                     = &MonitorSQLServerResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorSQLServerResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorSQLServerResource{}
+	_ resource.ResourceWithImportState      = &MonitorSQLServerResource{}
 )
 
 // NewMonitorSQLServerResource returns a new instance of the SQL Server monitor resource.
@@ -28,12 +30,14 @@ func NewMonitorSQLServerResource() resource.Resource {
 
 // MonitorSQLServerResource defines the resource implementation.
 type MonitorSQLServerResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorSQLServerResourceModel describes the resource data model.
 type MonitorSQLServerResourceModel struct {
 	MonitorBaseModel
+	MonitorDatabaseConnectionModel
 
 	DatabaseConnectionString types.String `tfsdk:"database_connection_string"`
 	DatabaseQuery            types.String `tfsdk:"database_query"`
@@ -57,11 +61,12 @@ func (*MonitorSQLServerResource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "SQL Server monitor resource",
-		Attributes: withMonitorBaseAttributes(map[string]schema.Attribute{
+		Attributes: withMonitorBaseAttributes(withDatabaseConnectionAttributes(map[string]schema.Attribute{
 			"database_connection_string": schema.StringAttribute{
-				MarkdownDescription: "SQL Server connection string",
-				Required:            true,
-				Sensitive:           true,
+				MarkdownDescription: "SQL Server connection string. " +
+					"Conflicts with the structured `database_*` attributes",
+				Optional:  true,
+				Sensitive: true,
 			},
 			"database_query": schema.StringAttribute{
 				MarkdownDescription: "SQL query to execute for health check",
@@ -70,10 +75,24 @@ func (*MonitorSQLServerResource) Schema(
 				Default:             stringdefault.StaticString("SELECT 1"),
 			},
 			"conditions": conditionsAttribute(),
-		}),
+		}, sqlServerDatabaseDriver())),
 	}
 }
 
+// ConfigValidators returns the config validators for the resource.
+func (*MonitorSQLServerResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return databaseConnectionConfigValidators()
+}
+
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorSQLServerResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the SQL Server monitor resource with the API client.
 func (r *MonitorSQLServerResource) Configure(
 	_ context.Context,
@@ -81,6 +100,7 @@ func (r *MonitorSQLServerResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new SQL Server monitor resource.
@@ -108,9 +128,15 @@ func (r *MonitorSQLServerResource) Create(
 			IsActive:       data.Active.ValueBool(),
 		},
 		SQLServerDetails: monitor.SQLServerDetails{
-			DatabaseConnectionString: data.DatabaseConnectionString.ValueString(),
-			DatabaseQuery:            ptrString(data.DatabaseQuery.ValueString()),
-			Conditions:               buildConditions(ctx, data.Conditions, &resp.Diagnostics),
+			DatabaseConnectionString: buildDatabaseConnectionString(
+				ctx,
+				sqlServerDatabaseDriver(),
+				data.DatabaseConnectionString,
+				&data.MonitorDatabaseConnectionModel,
+				&resp.Diagnostics,
+			),
+			DatabaseQuery: ptrString(data.DatabaseQuery.ValueString()),
+			Conditions:    buildConditions(ctx, data.Conditions, &resp.Diagnostics),
 		},
 	}
 
@@ -147,7 +173,7 @@ func (r *MonitorSQLServerResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -214,7 +240,14 @@ func (r *MonitorSQLServerResource) Read(
 	data.MaxRetries = types.Int64Value(sqlserverMonitor.MaxRetries)
 	data.UpsideDown = types.BoolValue(sqlserverMonitor.UpsideDown)
 	data.Active = types.BoolValue(sqlserverMonitor.IsActive)
-	data.DatabaseConnectionString = types.StringValue(sqlserverMonitor.DatabaseConnectionString)
+	populateDatabaseConnectionString(
+		ctx,
+		sqlServerDatabaseDriver(),
+		sqlserverMonitor.DatabaseConnectionString,
+		&data.DatabaseConnectionString,
+		&data.MonitorDatabaseConnectionModel,
+		&resp.Diagnostics,
+	)
 	if sqlserverMonitor.DatabaseQuery != nil {
 		data.DatabaseQuery = types.StringValue(*sqlserverMonitor.DatabaseQuery)
 	} else {
@@ -286,9 +319,15 @@ func (r *MonitorSQLServerResource) Update(
 			IsActive:       data.Active.ValueBool(),
 		},
 		SQLServerDetails: monitor.SQLServerDetails{
-			DatabaseConnectionString: data.DatabaseConnectionString.ValueString(),
-			DatabaseQuery:            ptrString(data.DatabaseQuery.ValueString()),
-			Conditions:               buildConditions(ctx, data.Conditions, &resp.Diagnostics),
+			DatabaseConnectionString: buildDatabaseConnectionString(
+				ctx,
+				sqlServerDatabaseDriver(),
+				data.DatabaseConnectionString,
+				&data.MonitorDatabaseConnectionModel,
+				&resp.Diagnostics,
+			),
+			DatabaseQuery: ptrString(data.DatabaseQuery.ValueString()),
+			Conditions:    buildConditions(ctx, data.Conditions, &resp.Diagnostics),
 		},
 	}
 
@@ -323,7 +362,15 @@ func (r *MonitorSQLServerResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -22,6 +22,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorSteamResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorSteamResource{}
 	_ resource.ResourceWithImportState = &MonitorSteamResource{}
 )
 
@@ -32,7 +33,8 @@ func NewMonitorSteamResource() resource.Resource {
 
 // MonitorSteamResource defines the resource implementation for Steam game server monitors.
 type MonitorSteamResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorSteamResourceModel describes the resource data model for Steam monitors.
@@ -95,6 +97,15 @@ func (*MonitorSteamResource) Schema(
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorSteamResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the Steam monitor resource with the API client.
 func (r *MonitorSteamResource) Configure(
 	_ context.Context,
@@ -102,6 +113,7 @@ func (r *MonitorSteamResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new Steam monitor resource.
@@ -131,7 +143,7 @@ func (r *MonitorSteamResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -220,7 +232,15 @@ func (r *MonitorSteamResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -19,6 +19,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorSystemServiceResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorSystemServiceResource{}
 	_ resource.ResourceWithImportState = &MonitorSystemServiceResource{}
 )
 
@@ -29,7 +30,8 @@ func NewMonitorSystemServiceResource() resource.Resource {
 
 // MonitorSystemServiceResource defines the resource implementation.
 type MonitorSystemServiceResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorSystemServiceResourceModel describes the resource data model.
@@ -71,6 +73,15 @@ func (*MonitorSystemServiceResource) Schema(
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorSystemServiceResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the System Service monitor resource with the API client.
 func (r *MonitorSystemServiceResource) Configure(
 	_ context.Context,
@@ -78,6 +89,7 @@ func (r *MonitorSystemServiceResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new System Service monitor resource.
@@ -138,7 +150,7 @@ func (r *MonitorSystemServiceResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -300,7 +312,15 @@ func (r *MonitorSystemServiceResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
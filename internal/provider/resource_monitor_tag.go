@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+var (
+	_ resource.Resource                = &MonitorTagResource{}
+	_ resource.ResourceWithImportState = &MonitorTagResource{}
+)
+
+// NewMonitorTagResource returns a new instance of the MonitorTag resource.
+func NewMonitorTagResource() resource.Resource {
+	return &MonitorTagResource{}
+}
+
+// MonitorTagResource defines the resource implementation.
+type MonitorTagResource struct {
+	client *kuma.Client
+}
+
+// MonitorTagResourceModel describes the MonitorTag resource data model.
+type MonitorTagResourceModel struct {
+	MonitorID types.Int64  `tfsdk:"monitor_id"`
+	TagID     types.Int64  `tfsdk:"tag_id"`
+	Value     types.String `tfsdk:"value"`
+}
+
+// Metadata returns the metadata for the resource.
+func (*MonitorTagResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_tag"
+}
+
+// Schema returns the schema for the resource.
+func (*MonitorTagResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attach a tag to a monitor. Other tags of the monitor are left untouched. " +
+			"The monitor must not manage its tags in its `tags` attribute.",
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.Int64Attribute{
+				MarkdownDescription: "Monitor ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"tag_id": schema.Int64Attribute{
+				MarkdownDescription: "Tag ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Optional value for this tag",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the API client.
+func (r *MonitorTagResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates a new resource.
+func (r *MonitorTagResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data MonitorTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID := data.MonitorID.ValueInt64()
+	tagID := data.TagID.ValueInt64()
+
+	mon, err := r.client.GetMonitor(ctx, monitorID)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read monitor", err.Error())
+		return
+	}
+
+	if hasMonitorTag(mon.Tags, tagID, data.Value.ValueString()) {
+		resp.Diagnostics.AddError(
+			"Monitor Tag Already Exists",
+			fmt.Sprintf(
+				"The tag %d with value %q is already attached to monitor %d. Import it instead.",
+				tagID, data.Value.ValueString(), monitorID,
+			),
+		)
+		return
+	}
+
+	_, err = r.client.AddMonitorTag(ctx, tagID, monitorID, data.Value.ValueString())
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to add tag %d to monitor %d", tagID, monitorID), err.Error())
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the current state of the resource.
+func (r *MonitorTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MonitorTagResourceModel
+
+	// Get resource from state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mon, err := r.client.GetMonitor(ctx, data.MonitorID.ValueInt64())
+	// Handle error.
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to read monitor tag", err.Error())
+		return
+	}
+
+	if !hasMonitorTag(mon.Tags, data.TagID.ValueInt64(), data.Value.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource. All attributes require a replacement, so
+// there is nothing to update on the server.
+func (*MonitorTagResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data MonitorTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource.
+func (r *MonitorTagResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data MonitorTagResourceModel
+
+	// Get resource from state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMonitorTagWithValue(
+		ctx,
+		data.TagID.ValueInt64(),
+		data.MonitorID.ValueInt64(),
+		data.Value.ValueString(),
+	)
+	// Handle error.
+	if err != nil {
+		if isNotFoundError(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to delete monitor tag", err.Error())
+		return
+	}
+}
+
+// ImportState imports an existing resource by `<monitor_id>/<tag_id>` or
+// `<monitor_id>/<tag_id>/<value>`.
+func (*MonitorTagResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	monitorID, tagID, value, err := parseMonitorTagID(req.ID)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf(
+				"Import ID must be in the format <monitor_id>/<tag_id> or <monitor_id>/<tag_id>/<value>, got: %s",
+				req.ID,
+			),
+		)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_id"), monitorID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag_id"), tagID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), value)...)
+}
+
+// hasMonitorTag reports, whether the tag with the given value is attached to
+// the monitor. An empty value matches a tag without value.
+func hasMonitorTag(monitorTags []tag.MonitorTag, tagID int64, value string) bool {
+	for _, monitorTag := range monitorTags {
+		if monitorTag.TagID == tagID && monitorTag.Value == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseMonitorTagID parses an import ID in the format `<monitor_id>/<tag_id>`
+// or `<monitor_id>/<tag_id>/<value>`. The value may contain slashes.
+func parseMonitorTagID(id string) (int64, int64, types.String, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) < 2 {
+		return 0, 0, types.StringNull(), fmt.Errorf("missing separator in %q", id)
+	}
+
+	monitorID, err := strconv.ParseInt(parts[0], 10, 64)
+	// Handle error.
+	if err != nil {
+		return 0, 0, types.StringNull(), fmt.Errorf("invalid monitor ID: %w", err)
+	}
+
+	tagID, err := strconv.ParseInt(parts[1], 10, 64)
+	// Handle error.
+	if err != nil {
+		return 0, 0, types.StringNull(), fmt.Errorf("invalid tag ID: %w", err)
+	}
+
+	value := types.StringNull()
+	if len(parts) == 3 && parts[2] != "" {
+		value = types.StringValue(parts[2])
+	}
+
+	return monitorID, tagID, value, nil
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestParseMonitorTagID(t *testing.T) {
+	tests := []struct {
+		id            string
+		wantMonitorID int64
+		wantTagID     int64
+		wantValue     types.String
+		wantErr       bool
+	}{
+		{id: "12/3", wantMonitorID: 12, wantTagID: 3, wantValue: types.StringNull()},
+		{id: "12/3/", wantMonitorID: 12, wantTagID: 3, wantValue: types.StringNull()},
+		{id: "12/3/CC-1234", wantMonitorID: 12, wantTagID: 3, wantValue: types.StringValue("CC-1234")},
+		{id: "12/3/a/b", wantMonitorID: 12, wantTagID: 3, wantValue: types.StringValue("a/b")},
+		{id: "12", wantErr: true},
+		{id: "a/3", wantErr: true},
+		{id: "12/b", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			monitorID, tagID, value, err := parseMonitorTagID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMonitorTagID(%q) error = %v, want error %t", tt.id, err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if monitorID != tt.wantMonitorID || tagID != tt.wantTagID || !value.Equal(tt.wantValue) {
+				t.Fatalf(
+					"parseMonitorTagID(%q) = %d, %d, %s, want %d, %d, %s",
+					tt.id, monitorID, tagID, value, tt.wantMonitorID, tt.wantTagID, tt.wantValue,
+				)
+			}
+		})
+	}
+}
+
+func TestAccMonitorTagResource(t *testing.T) {
+	monitorName := acctest.RandomWithPrefix("TestMonitorTag")
+	tagName := acctest.RandomWithPrefix("TestMonitorTagCostCenter")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorTagResourceConfig(monitorName, tagName, "CC-1234"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_tag.test",
+						tfjsonpath.New("value"),
+						knownvalue.StringExact("CC-1234"),
+					),
+				},
+			},
+			{
+				// The monitor does not manage its tags, so the association
+				// must not cause a diff on the monitor.
+				Config: testAccMonitorTagResourceConfig(monitorName, tagName, "CC-1234"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_monitor_http.test",
+						tfjsonpath.New("tags"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+			},
+			{
+				Config: testAccMonitorTagResourceConfig(monitorName, tagName, "CC-5678"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"uptimekuma_monitor_tag.test",
+							plancheck.ResourceActionDestroyBeforeCreate,
+						),
+					},
+				},
+			},
+			{
+				ResourceName:                         "uptimekuma_monitor_tag.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "monitor_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["uptimekuma_monitor_tag.test"]
+					return rs.Primary.Attributes["monitor_id"] + "/" + rs.Primary.Attributes["tag_id"] + "/" +
+						rs.Primary.Attributes["value"], nil
+				},
+			},
+		},
+	})
+}
+
+func testAccMonitorTagResourceConfig(monitorName string, tagName string, value string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_tag" "test" {
+  name  = %[2]q
+  color = "#6b7280"
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  name = %[1]q
+  url  = "https://example.com"
+}
+
+resource "uptimekuma_monitor_tag" "test" {
+  monitor_id = uptimekuma_monitor_http.test.id
+  tag_id     = uptimekuma_tag.test.id
+  value      = %[3]q
+}
+`, monitorName, tagName, value)
+}
@@ -17,6 +17,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorTailscalePingResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorTailscalePingResource{}
 	_ resource.ResourceWithImportState = &MonitorTailscalePingResource{}
 )
 
@@ -27,7 +28,8 @@ func NewMonitorTailscalePingResource() resource.Resource {
 
 // MonitorTailscalePingResource defines the resource implementation.
 type MonitorTailscalePingResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorTailscalePingResourceModel describes the resource data model.
@@ -65,6 +67,15 @@ func (*MonitorTailscalePingResource) Schema(
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorTailscalePingResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the Tailscale Ping monitor resource with the API client.
 func (r *MonitorTailscalePingResource) Configure(
 	_ context.Context,
@@ -72,6 +83,7 @@ func (r *MonitorTailscalePingResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new Tailscale Ping monitor resource.
@@ -133,7 +145,7 @@ func (r *MonitorTailscalePingResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -295,7 +307,15 @@ func (r *MonitorTailscalePingResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
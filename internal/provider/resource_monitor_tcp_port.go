@@ -20,6 +20,7 @@ import (
 
 var (
 	_ resource.Resource                = &MonitorTCPPortResource{}
+	_ resource.ResourceWithModifyPlan  = &MonitorTCPPortResource{}
 	_ resource.ResourceWithImportState = &MonitorTCPPortResource{}
 )
 
@@ -30,7 +31,8 @@ func NewMonitorTCPPortResource() resource.Resource {
 
 // MonitorTCPPortResource defines the resource implementation.
 type MonitorTCPPortResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorTCPPortResourceModel describes the resource data model.
@@ -79,6 +81,15 @@ func (*MonitorTCPPortResource) Schema(
 	}
 }
 
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorTCPPortResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the TCP Port monitor resource with the API client.
 func (r *MonitorTCPPortResource) Configure(
 	_ context.Context,
@@ -86,6 +97,7 @@ func (r *MonitorTCPPortResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new TCP Port monitor resource.
@@ -148,7 +160,7 @@ func (r *MonitorTCPPortResource) Create(
 
 	data.ID = types.Int64Value(id)
 
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -308,7 +320,15 @@ func (r *MonitorTCPPortResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
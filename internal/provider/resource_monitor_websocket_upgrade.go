@@ -19,8 +19,10 @@ import (
 
 var (
 	// Ensure MonitorWebsocketUpgradeResource satisfies various resource interfaces.
-	_ resource.Resource                = &MonitorWebsocketUpgradeResource{}
-	_ resource.ResourceWithImportState = &MonitorWebsocketUpgradeResource{}
+	_ resource.Resource                     = &MonitorWebsocketUpgradeResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorWebsocketUpgradeResource{}
+	_ resource.ResourceWithImportState      = &MonitorWebsocketUpgradeResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorWebsocketUpgradeResource{}
 )
 
 // NewMonitorWebsocketUpgradeResource returns a new instance of the Websocket Upgrade monitor resource.
@@ -30,7 +32,8 @@ func NewMonitorWebsocketUpgradeResource() resource.Resource {
 
 // MonitorWebsocketUpgradeResource defines the resource implementation.
 type MonitorWebsocketUpgradeResource struct {
-	client *kuma.Client
+	client     *kuma.Client
+	tagOptions monitorTagOptions
 }
 
 // MonitorWebsocketUpgradeResourceModel describes the resource data model for Websocket Upgrade monitors.
@@ -81,6 +84,20 @@ func (*MonitorWebsocketUpgradeResource) Schema(
 	}
 }
 
+// ConfigValidators returns the config validators for the resource.
+func (*MonitorWebsocketUpgradeResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return httpMonitorConfigValidators()
+}
+
+// ModifyPlan validates the references to other objects at plan time.
+func (r *MonitorWebsocketUpgradeResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	modifyMonitorPlan(ctx, r.client, req, resp)
+}
+
 // Configure configures the resource with the API client.
 func (r *MonitorWebsocketUpgradeResource) Configure(
 	_ context.Context,
@@ -88,6 +105,7 @@ func (r *MonitorWebsocketUpgradeResource) Configure(
 	resp *resource.ConfigureResponse,
 ) {
 	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.tagOptions = configureMonitorTagOptions(req.ProviderData)
 }
 
 // Create creates a new resource.
@@ -114,7 +132,7 @@ func (r *MonitorWebsocketUpgradeResource) Create(
 	}
 
 	data.ID = types.Int64Value(id)
-	handleMonitorTagsCreate(ctx, r.client, id, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsCreate(ctx, r.client, r.tagOptions, id, &data.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -160,7 +178,7 @@ func buildWebsocketUpgradeMonitor(
 			AcceptedStatusCodes:      []string{},
 			HTTPBodyEncoding:         data.HTTPBodyEncoding.ValueString(),
 			Body:                     data.Body.ValueString(),
-			Headers:                  data.Headers.ValueString(),
+			Headers:                  buildHTTPHeaders(ctx, &data.MonitorHTTPBaseModel, diags),
 			AuthMethod:               monitor.AuthMethod(data.AuthMethod.ValueString()),
 			BasicAuthUser:            data.BasicAuthUser.ValueString(),
 			BasicAuthPass:            data.BasicAuthPass.ValueString(),
@@ -253,8 +271,8 @@ func populateHTTPBaseFieldsForWebsocketUpgrade(httpMonitor *monitor.HTTP, m *Mon
 	m.IgnoreTLS = types.BoolValue(httpMonitor.IgnoreTLS)
 	m.MaxRedirects = types.Int64Value(int64(httpMonitor.MaxRedirects))
 	m.HTTPBodyEncoding = types.StringValue(httpMonitor.HTTPBodyEncoding)
-	m.Body = stringOrNullWebsocketUpgrade(httpMonitor.Body)
-	m.Headers = stringOrNullWebsocketUpgrade(httpMonitor.Headers)
+	m.Body = normalizedJSONOrNull(httpMonitor.Body)
+	populateHTTPHeaders(httpMonitor.Headers, &m.MonitorHTTPBaseModel)
 	m.AuthMethod = types.StringValue(string(httpMonitor.AuthMethod))
 	m.BasicAuthUser = stringOrNullWebsocketUpgrade(httpMonitor.BasicAuthUser)
 	m.BasicAuthPass = stringOrNullWebsocketUpgrade(httpMonitor.BasicAuthPass)
@@ -397,7 +415,15 @@ func (r *MonitorWebsocketUpgradeResource) Update(
 		return
 	}
 
-	handleMonitorTagsUpdate(ctx, r.client, data.ID.ValueInt64(), state.Tags, data.Tags, &resp.Diagnostics)
+	handleMonitorTagsUpdate(
+		ctx,
+		r.client,
+		r.tagOptions,
+		data.ID.ValueInt64(),
+		state.Tags,
+		&data.Tags,
+		&resp.Diagnostics,
+	)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,426 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+	"github.com/breml/go-uptime-kuma-client/notification"
+)
+
+// providerAddressSuffix is the suffix of the provider source address, e.g.
+// `registry.terraform.io/breml/uptimekuma`.
+const providerAddressSuffix = "/uptimekuma"
+
+// monitorTypeChangePrivateKey is the private state key marking a moved
+// monitor, whose type in Uptime Kuma is changed by the next update.
+const monitorTypeChangePrivateKey = "monitor_type_change"
+
+// privateState is implemented by the private state data of the resource
+// requests.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// isMonitorTypeChangePending reports whether the monitor has been moved from
+// another monitor resource and its type has not been changed yet.
+func isMonitorTypeChangePending(ctx context.Context, private privateState) bool {
+	if private == nil || reflect.ValueOf(private).IsNil() {
+		return false
+	}
+
+	value, diags := private.GetKey(ctx, monitorTypeChangePrivateKey)
+
+	return !diags.HasError() && len(value) > 0
+}
+
+// privateStateWriter is implemented by the private state data of the
+// resource responses.
+type privateStateWriter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// planMonitorTypeChange forces the update of a moved monitor, whose type has
+// not been changed yet, even if its attributes are unchanged. The ID is the
+// only attribute, which is never configured, so it is planned as unknown.
+func planMonitorTypeChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !isMonitorTypeChangePending(ctx, req.Private) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.Int64Unknown())...)
+}
+
+// completeMonitorTypeChange removes the mark of a moved monitor from the
+// private state, once Uptime Kuma reports the monitor with monitorType.
+func completeMonitorTypeChange(
+	ctx context.Context,
+	client *kuma.Client,
+	id int64,
+	monitorType string,
+	private privateStateWriter,
+	diags *diag.Diagnostics,
+) {
+	mon, err := client.GetMonitor(ctx, id)
+	// Handle error.
+	if err != nil {
+		diags.AddError("failed to read monitor", err.Error())
+		return
+	}
+
+	if actual := mon.Type(); actual != monitorType {
+		diags.AddError(
+			"Monitor Type Not Changed",
+			fmt.Sprintf(
+				"Uptime Kuma reports the type %q for monitor %d after the update, expected %q. The update is "+
+					"retried on the next apply.",
+				actual, id, monitorType,
+			),
+		)
+
+		return
+	}
+
+	diags.Append(private.SetKey(ctx, monitorTypeChangePrivateKey, nil)...)
+}
+
+// isMoveFromResource reports whether the move state request originates from
+// the given resource type of this provider.
+func isMoveFromResource(req resource.MoveStateRequest, typeName string) bool {
+	return req.SourceTypeName == typeName && strings.HasSuffix(req.SourceProviderAddress, providerAddressSuffix)
+}
+
+// resourceTypeNameAndSchema returns the type name and the schema of the
+// resource created by factory.
+func resourceTypeNameAndSchema(ctx context.Context, factory func() resource.Resource) (string, schema.Schema) {
+	res := factory()
+
+	metadataResp := resource.MetadataResponse{}
+	res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "uptimekuma"}, &metadataResp)
+
+	schemaResp := resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return metadataResp.TypeName, schemaResp.Schema
+}
+
+// moveMonitorState returns state movers, which move the state of the given
+// monitor resources into the target resource. All attributes with the same
+// name and type are carried over, the remaining attributes are null and are
+// populated by the subsequent refresh. The monitor itself, including its ID
+// and heartbeat history, is kept. The next plan forces an update, which
+// changes the monitor type; until then the mismatching type is tolerated on
+// refresh.
+func moveMonitorState(ctx context.Context, sources ...func() resource.Resource) []resource.StateMover {
+	movers := make([]resource.StateMover, 0, len(sources))
+
+	for _, source := range sources {
+		typeName, sourceSchema := resourceTypeNameAndSchema(ctx, source)
+
+		movers = append(movers, resource.StateMover{
+			SourceSchema: &sourceSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFromResource(req, typeName) || req.SourceState == nil {
+					return
+				}
+
+				var source map[string]tftypes.Value
+
+				err := req.SourceState.Raw.As(&source)
+				// Handle error.
+				if err != nil {
+					resp.Diagnostics.AddError("failed to move monitor state", err.Error())
+					return
+				}
+
+				targetType, _ := resp.TargetState.Schema.Type().TerraformType(ctx).(tftypes.Object)
+				target := make(map[string]tftypes.Value, len(targetType.AttributeTypes))
+
+				for name, attrType := range targetType.AttributeTypes {
+					value, ok := source[name]
+					if !ok || !value.Type().Equal(attrType) {
+						value = tftypes.NewValue(attrType, nil)
+					}
+
+					target[name] = value
+				}
+
+				resp.TargetState.Raw = tftypes.NewValue(targetType, target)
+				resp.Diagnostics.Append(
+					resp.TargetPrivate.SetKey(ctx, monitorTypeChangePrivateKey, []byte(`true`))...,
+				)
+			},
+		})
+	}
+
+	return movers
+}
+
+// moveGenericNotificationState returns a state mover, which moves the state
+// of a generic `uptimekuma_notification` of the given notification type into
+// the typed notification resource. The JSON config of the generic
+// notification is decoded into the typed attributes.
+func moveGenericNotificationState(ctx context.Context, notificationType string) resource.StateMover {
+	typeName, sourceSchema := resourceTypeNameAndSchema(ctx, NewNotificationResource)
+
+	return resource.StateMover{
+		SourceSchema: &sourceSchema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if !isMoveFromResource(req, typeName) || req.SourceState == nil {
+				return
+			}
+
+			var source NotificationResourceModel
+
+			resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if source.Type.ValueString() != notificationType {
+				resp.Diagnostics.AddError(
+					"invalid notification type",
+					fmt.Sprintf(
+						"Cannot move a notification of type %q into a resource for notifications of type %q.",
+						source.Type.ValueString(), notificationType,
+					),
+				)
+
+				return
+			}
+
+			target, ok := notificationModelsByType()[notificationType]
+			if !ok {
+				resp.Diagnostics.AddError(
+					"unsupported notification type",
+					fmt.Sprintf("Notification type %q is not supported.", notificationType),
+				)
+
+				return
+			}
+
+			err := decodeGenericNotification(source, target)
+			// Handle error.
+			if err != nil {
+				resp.Diagnostics.AddError("failed to decode notification config", err.Error())
+				return
+			}
+
+			targetSchema, ok := resp.TargetState.Schema.(schema.Schema)
+			if !ok {
+				resp.Diagnostics.AddError(
+					"failed to move notification state",
+					fmt.Sprintf("Unexpected target schema type %T.", resp.TargetState.Schema),
+				)
+
+				return
+			}
+
+			resp.TargetState.Raw = typedNotificationState(ctx, targetSchema, source, target)
+		},
+	}
+}
+
+// decodeGenericNotification decodes the config of a generic notification
+// into target, which must be a pointer to a typed notification.
+func decodeGenericNotification(source NotificationResourceModel, target notification.Notification) error {
+	config := map[string]any{}
+
+	if source.Config.ValueString() != "" {
+		err := json.Unmarshal([]byte(source.Config.ValueString()), &config)
+		if err != nil {
+			return fmt.Errorf("unmarshal config: %w", err)
+		}
+	}
+
+	config["type"] = source.Type.ValueString()
+
+	configStr, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"id":        source.ID.ValueInt64(),
+		"name":      source.Name.ValueString(),
+		"active":    source.IsActive.ValueBool(),
+		"isDefault": source.IsDefault.ValueBool(),
+		"config":    string(configStr),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal notification: %w", err)
+	}
+
+	var base notification.Base
+
+	err = json.Unmarshal(data, &base)
+	if err != nil {
+		return fmt.Errorf("unmarshal notification: %w", err)
+	}
+
+	err = base.As(target)
+	if err != nil {
+		return fmt.Errorf("convert notification: %w", err)
+	}
+
+	return nil
+}
+
+// typedNotificationState builds the state of a typed notification resource.
+// The common attributes are taken from the generic notification, the type
+// specific attributes are matched case-insensitively by name, ignoring
+// underscores, with the fields of the decoded notification, e.g.
+// `webhook_url` with `WebhookURL` or `WebHookURL`. Attributes without
+// a matching field remain null and are populated by the subsequent refresh.
+func typedNotificationState(
+	ctx context.Context,
+	targetSchema schema.Schema,
+	source NotificationResourceModel,
+	decoded notification.Notification,
+) tftypes.Value {
+	targetType, _ := targetSchema.Type().TerraformType(ctx).(tftypes.Object)
+	fields := notificationFields(reflect.ValueOf(decoded))
+
+	common := map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(source.ID.ValueInt64())),
+		"name":           tftypes.NewValue(tftypes.String, source.Name.ValueString()),
+		"is_active":      tftypes.NewValue(tftypes.Bool, source.IsActive.ValueBool()),
+		"is_default":     tftypes.NewValue(tftypes.Bool, source.IsDefault.ValueBool()),
+		"apply_existing": tftypes.NewValue(tftypes.Bool, source.ApplyExisting.ValueBool()),
+	}
+
+	target := make(map[string]tftypes.Value, len(targetType.AttributeTypes))
+
+	for name, attrType := range targetType.AttributeTypes {
+		value, ok := common[name]
+		if !ok {
+			required := false
+			attr, found := targetSchema.Attributes[name]
+			if found {
+				required = attr.IsRequired()
+			}
+
+			value = fieldValue(fields[strings.ReplaceAll(name, "_", "")], attrType, required)
+		}
+
+		target[name] = value
+	}
+
+	return tftypes.NewValue(targetType, target)
+}
+
+// notificationFields returns the fields of the typed notification, excluding
+// the fields of notification.Base, by their lower case name.
+func notificationFields(value reflect.Value) map[string]reflect.Value {
+	fields := map[string]reflect.Value{}
+
+	for value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if !field.IsExported() || field.Type == reflect.TypeFor[notification.Base]() {
+			continue
+		}
+
+		if field.Anonymous {
+			for name, nested := range notificationFields(value.Field(i)) {
+				fields[name] = nested
+			}
+
+			continue
+		}
+
+		fields[strings.ToLower(field.Name)] = value.Field(i)
+	}
+
+	return fields
+}
+
+// fieldValue converts a field of a typed notification into a value of the
+// given attribute type. Empty strings of optional attributes and values,
+// which cannot be converted, result in a null value.
+func fieldValue(field reflect.Value, typ tftypes.Type, required bool) tftypes.Value {
+	null := tftypes.NewValue(typ, nil)
+
+	if !field.IsValid() {
+		return null
+	}
+
+	for field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return null
+		}
+
+		field = field.Elem()
+	}
+
+	switch {
+	case typ.Is(tftypes.String) && field.Kind() == reflect.String:
+		if field.String() == "" && !required {
+			return null
+		}
+
+		return tftypes.NewValue(typ, field.String())
+
+	case typ.Is(tftypes.Bool) && field.Kind() == reflect.Bool:
+		return tftypes.NewValue(typ, field.Bool())
+
+	case typ.Is(tftypes.Number) && field.CanInt():
+		return tftypes.NewValue(typ, new(big.Float).SetInt64(field.Int()))
+
+	case typ.Is(tftypes.Number) && field.CanUint():
+		return tftypes.NewValue(typ, new(big.Float).SetUint64(field.Uint()))
+
+	case typ.Is(tftypes.Number) && field.CanFloat():
+		return tftypes.NewValue(typ, big.NewFloat(field.Float()))
+
+	case typ.Is(tftypes.List{ElementType: tftypes.String}) || typ.Is(tftypes.Set{ElementType: tftypes.String}):
+		return stringCollectionValue(field, typ)
+
+	case typ.Is(tftypes.Map{ElementType: tftypes.String}) && field.Kind() == reflect.Map:
+		if field.Len() == 0 || field.Type().Key().Kind() != reflect.String || field.Type().Elem().Kind() != reflect.String {
+			return null
+		}
+
+		elems := make(map[string]tftypes.Value, field.Len())
+		for _, key := range field.MapKeys() {
+			elems[key.String()] = tftypes.NewValue(tftypes.String, field.MapIndex(key).String())
+		}
+
+		return tftypes.NewValue(typ, elems)
+
+	default:
+		return null
+	}
+}
+
+// stringCollectionValue converts a slice of strings into a list or set value.
+func stringCollectionValue(field reflect.Value, typ tftypes.Type) tftypes.Value {
+	if field.Kind() != reflect.Slice || field.Len() == 0 || field.Type().Elem().Kind() != reflect.String {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	elems := make([]tftypes.Value, 0, field.Len())
+	for i := range field.Len() {
+		elems = append(elems, tftypes.NewValue(tftypes.String, field.Index(i).String()))
+	}
+
+	return tftypes.NewValue(typ, elems)
+}
@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	kuma "github.com/breml/go-uptime-kuma-client"
+)
+
+const testProviderAddress = "registry.terraform.io/breml/uptimekuma"
+
+func moveResourceState(
+	t *testing.T,
+	sourceTypeName string,
+	sourceState string,
+	targetTypeName string,
+) (map[string]tftypes.Value, *tfprotov6.MoveResourceStateResponse) {
+	t.Helper()
+
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemaResp, err := server.GetProviderSchema(t.Context(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error: %v", err)
+	}
+
+	resp, err := server.MoveResourceState(t.Context(), &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: testProviderAddress,
+		SourceTypeName:        sourceTypeName,
+		SourceState:           &tfprotov6.RawState{JSON: []byte(sourceState)},
+		TargetTypeName:        targetTypeName,
+	})
+	if err != nil {
+		t.Fatalf("MoveResourceState() error: %v", err)
+	}
+
+	if resp.TargetState == nil {
+		return nil, resp
+	}
+
+	value, err := resp.TargetState.Unmarshal(schemaResp.ResourceSchemas[targetTypeName].ValueType())
+	if err != nil {
+		t.Fatalf("Unmarshal() error: %v", err)
+	}
+
+	attrs := map[string]tftypes.Value{}
+
+	err = value.As(&attrs)
+	if err != nil {
+		t.Fatalf("As() error: %v", err)
+	}
+
+	return attrs, resp
+}
+
+func assertNoErrorDiagnostics(t *testing.T, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+func hasErrorDiagnostic(diags []*tfprotov6.Diagnostic, summary string) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError && strings.Contains(d.Summary, summary) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestMoveMonitorState(t *testing.T) {
+	attrs, resp := moveResourceState(
+		t,
+		"uptimekuma_monitor_http",
+		`{
+			"id": 42,
+			"name": "web",
+			"url": "https://example.com",
+			"interval": 60,
+			"basic_auth_pass_wo_version": 2,
+			"unknown_attribute": "ignored"
+		}`,
+		"uptimekuma_monitor_http_keyword",
+	)
+
+	assertNoErrorDiagnostics(t, resp.Diagnostics)
+
+	if attrs == nil {
+		t.Fatal("expected target state")
+	}
+
+	wantNumbers := map[string]int64{"id": 42, "interval": 60, "basic_auth_pass_wo_version": 2}
+	for name, want := range wantNumbers {
+		var got big.Float
+
+		err := attrs[name].As(&got)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		if got.Cmp(new(big.Float).SetInt64(want)) != 0 {
+			t.Errorf("%s = %s, want %d", name, got.String(), want)
+		}
+	}
+
+	var url string
+
+	err := attrs["url"].As(&url)
+	if err != nil || url != "https://example.com" {
+		t.Errorf("url = %q (%v), want %q", url, err, "https://example.com")
+	}
+
+	if !attrs["keyword"].IsNull() {
+		t.Errorf("expected keyword to be null, got %s", attrs["keyword"])
+	}
+
+	if !strings.Contains(string(resp.TargetPrivate), monitorTypeChangePrivateKey) {
+		t.Errorf("expected private state to contain %q, got %s", monitorTypeChangePrivateKey, resp.TargetPrivate)
+	}
+}
+
+func TestMoveMonitorStateUnsupportedSource(t *testing.T) {
+	_, resp := moveResourceState(
+		t,
+		"uptimekuma_monitor_ping",
+		`{"id": 42, "name": "ping", "hostname": "example.com"}`,
+		"uptimekuma_monitor_http_keyword",
+	)
+
+	if !hasErrorDiagnostic(resp.Diagnostics, "Unable to Move Resource State") {
+		t.Errorf("expected error diagnostic, got %v", resp.Diagnostics)
+	}
+}
+
+func TestMoveGenericNotificationState(t *testing.T) {
+	attrs, resp := moveResourceState(
+		t,
+		"uptimekuma_notification",
+		`{
+			"id": 7,
+			"name": "slack",
+			"is_active": true,
+			"is_default": false,
+			"apply_existing": false,
+			"type": "slack",
+			"config": "{\"slackwebhookURL\":\"https://hooks.slack.com/services/x\",\"slackusername\":\"\",`+
+			`\"slackchannel\":\"#alerts\",\"slackrichmessage\":true}"
+		}`,
+		"uptimekuma_notification_slack",
+	)
+
+	assertNoErrorDiagnostics(t, resp.Diagnostics)
+
+	if attrs == nil {
+		t.Fatal("expected target state")
+	}
+
+	wantStrings := map[string]string{
+		"name":        "slack",
+		"webhook_url": "https://hooks.slack.com/services/x",
+		"channel":     "#alerts",
+	}
+	for name, want := range wantStrings {
+		var got string
+
+		err := attrs[name].As(&got)
+		if err != nil || got != want {
+			t.Errorf("%s = %q (%v), want %q", name, got, err, want)
+		}
+	}
+
+	var richMessage bool
+
+	err := attrs["rich_message"].As(&richMessage)
+	if err != nil || !richMessage {
+		t.Errorf("rich_message = %t (%v), want true", richMessage, err)
+	}
+
+	if !attrs["username"].IsNull() {
+		t.Errorf("expected username to be null, got %s", attrs["username"])
+	}
+
+	if !attrs["webhook_url_wo_version"].IsNull() {
+		t.Errorf("expected webhook_url_wo_version to be null, got %s", attrs["webhook_url_wo_version"])
+	}
+}
+
+func TestMoveGenericNotificationStateTypeMismatch(t *testing.T) {
+	_, resp := moveResourceState(
+		t,
+		"uptimekuma_notification",
+		`{"id": 7, "name": "discord", "type": "discord", "config": "{}"}`,
+		"uptimekuma_notification_slack",
+	)
+
+	if !hasErrorDiagnostic(resp.Diagnostics, "invalid notification type") {
+		t.Errorf("expected error diagnostic, got %v", resp.Diagnostics)
+	}
+}
+
+func TestNotificationFieldsCoverTypedAttributes(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemaResp, err := server.GetProviderSchema(t.Context(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error: %v", err)
+	}
+
+	common := map[string]bool{"id": true, "name": true, "is_active": true, "is_default": true, "apply_existing": true}
+
+	for notificationType, model := range notificationModelsByType() {
+		typeName := "uptimekuma_notification_" + strings.ReplaceAll(notificationType, "-", "")
+
+		resourceSchema, ok := schemaResp.ResourceSchemas[typeName]
+		if !ok {
+			continue
+		}
+
+		fields := notificationFields(reflect.ValueOf(model))
+
+		for _, attr := range resourceSchema.Block.Attributes {
+			name := strings.TrimSuffix(strings.TrimSuffix(attr.Name, writeOnlyVersionSuffix), writeOnlySuffix)
+			if common[name] || name != attr.Name || attr.Type.Is(tftypes.Object{}) {
+				continue
+			}
+
+			if _, ok := fields[strings.ReplaceAll(name, "_", "")]; !ok {
+				t.Errorf("%s: no field for attribute %q", typeName, name)
+			}
+		}
+	}
+}
+
+// testAccCheckMonitorType checks the type of the monitor in Uptime Kuma, e.g.
+// after the monitor has been moved to another monitor resource.
+func testAccCheckMonitorType(
+	t *testing.T,
+	kumaClient *kuma.Client,
+	resourceAddr string,
+	want string,
+) resource.TestCheckFunc {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceAddr]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceAddr)
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.Attributes["id"], 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse monitor id: %w", err)
+		}
+
+		mon, err := kumaClient.GetMonitor(t.Context(), id)
+		if err != nil {
+			return fmt.Errorf("failed to get monitor %d: %w", id, err)
+		}
+
+		if mon.Type() != want {
+			return fmt.Errorf("monitor %d has type %q, want %q", id, mon.Type(), want)
+		}
+
+		return nil
+	}
+}
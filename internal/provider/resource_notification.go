@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	kuma "github.com/breml/go-uptime-kuma-client"
@@ -36,13 +37,13 @@ type NotificationResource struct {
 
 // NotificationResourceModel describes the resource data model.
 type NotificationResourceModel struct {
-	ID            types.Int64  `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	IsActive      types.Bool   `tfsdk:"is_active"`
-	IsDefault     types.Bool   `tfsdk:"is_default"`
-	ApplyExisting types.Bool   `tfsdk:"apply_existing"`
-	Type          types.String `tfsdk:"type"`
-	Config        types.String `tfsdk:"config"`
+	ID            types.Int64         `tfsdk:"id"`
+	Name          types.String        `tfsdk:"name"`
+	IsActive      types.Bool          `tfsdk:"is_active"`
+	IsDefault     types.Bool          `tfsdk:"is_default"`
+	ApplyExisting types.Bool          `tfsdk:"apply_existing"`
+	Type          types.String        `tfsdk:"type"`
+	Config        NormalizedJSONValue `tfsdk:"config"`
 }
 
 // Metadata returns the metadata for the resource.
@@ -91,7 +92,11 @@ func (*NotificationResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"config": schema.StringAttribute{
 				MarkdownDescription: "Notification configuration for the given type as JSON encoded object",
+				CustomType:          NormalizedJSONType{},
 				Required:            true,
+				Validators: []validator.String{
+					validateJSON(),
+				},
 			},
 		},
 	}
@@ -189,7 +194,7 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	data.IsDefault = types.BoolValue(genericNotification.IsDefault)
 	data.ApplyExisting = types.BoolValue(genericNotification.ApplyExisting)
 	data.Type = types.StringValue(genericNotification.Type())
-	data.Config = types.StringValue(string(genericDetailsJSON))
+	data.Config = NewNormalizedJSONValue(string(genericDetailsJSON))
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
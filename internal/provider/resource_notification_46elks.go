@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &Notification46ElksResource{}
 	_ resource.ResourceWithImportState = &Notification46ElksResource{}
+	_ resource.ResourceWithMoveState   = &Notification46ElksResource{}
 )
 
 // NewNotification46ElksResource returns a new instance of the 46elks notification resource.
@@ -283,3 +284,10 @@ func (*Notification46ElksResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*Notification46ElksResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.FortySixElks{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationAlertaResource{}
 	_ resource.ResourceWithImportState = &NotificationAlertaResource{}
+	_ resource.ResourceWithMoveState   = &NotificationAlertaResource{}
 )
 
 // NewNotificationAlertaResource returns a new instance of the Alerta notification resource.
@@ -292,3 +293,10 @@ func (*NotificationAlertaResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationAlertaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Alerta{}.Type()),
+	}
+}
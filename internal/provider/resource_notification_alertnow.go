@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationAlertNowResource{}
 	_ resource.ResourceWithImportState = &NotificationAlertNowResource{}
+	_ resource.ResourceWithMoveState   = &NotificationAlertNowResource{}
 )
 
 // NewNotificationAlertNowResource returns a new instance of the AlertNow notification resource.
@@ -249,3 +250,10 @@ func (*NotificationAlertNowResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationAlertNowResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.AlertNow{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationAliyunsmsResource{}
 	_ resource.ResourceWithImportState = &NotificationAliyunsmsResource{}
+	_ resource.ResourceWithMoveState   = &NotificationAliyunsmsResource{}
 )
 
 // NewNotificationAliyunsmsResource returns a new instance of the Aliyun SMS notification resource.
@@ -294,3 +295,10 @@ func (*NotificationAliyunsmsResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationAliyunsmsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.AliyunSMS{}.Type()),
+	}
+}
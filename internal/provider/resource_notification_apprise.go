@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationAppriseResource{}
 	_ resource.ResourceWithImportState = &NotificationAppriseResource{}
+	_ resource.ResourceWithMoveState   = &NotificationAppriseResource{}
 )
 
 // NewNotificationAppriseResource returns a new instance of the Apprise notification resource.
@@ -256,3 +257,10 @@ func (*NotificationAppriseResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationAppriseResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Apprise{}.Type()),
+	}
+}
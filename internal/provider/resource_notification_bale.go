@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationBaleResource{}
 	_ resource.ResourceWithImportState = &NotificationBaleResource{}
+	_ resource.ResourceWithMoveState   = &NotificationBaleResource{}
 )
 
 // NewNotificationBaleResource returns a new instance of the Bale notification resource.
@@ -260,3 +261,10 @@ func (*NotificationBaleResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationBaleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Bale{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationBarkResource{}
 	_ resource.ResourceWithImportState = &NotificationBarkResource{}
+	_ resource.ResourceWithMoveState   = &NotificationBarkResource{}
 )
 
 // NewNotificationBarkResource returns a new instance of the Bark notification resource.
@@ -283,3 +284,10 @@ func (*NotificationBarkResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationBarkResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Bark{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationBitrix24Resource{}
 	_ resource.ResourceWithImportState = &NotificationBitrix24Resource{}
+	_ resource.ResourceWithMoveState   = &NotificationBitrix24Resource{}
 )
 
 // NewNotificationBitrix24Resource returns a new instance of the Bitrix24 notification resource.
@@ -257,3 +258,10 @@ func (*NotificationBitrix24Resource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationBitrix24Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Bitrix24{}.Type()),
+	}
+}
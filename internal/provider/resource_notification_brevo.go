@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationBrevoResource{}
 	_ resource.ResourceWithImportState = &NotificationBrevoResource{}
+	_ resource.ResourceWithMoveState   = &NotificationBrevoResource{}
 )
 
 // NewNotificationBrevoResource returns a new instance of the Brevo notification resource.
@@ -319,3 +320,10 @@ func (*NotificationBrevoResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationBrevoResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Brevo{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationCallMeBotResource{}
 	_ resource.ResourceWithImportState = &NotificationCallMeBotResource{}
+	_ resource.ResourceWithMoveState   = &NotificationCallMeBotResource{}
 )
 
 // NewNotificationCallMeBotResource returns a new instance of the CallMeBot notification resource.
@@ -249,3 +250,10 @@ func (*NotificationCallMeBotResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationCallMeBotResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.CallMeBot{}.Type()),
+	}
+}
@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationCellsyntResource{}
 	_ resource.ResourceWithImportState = &NotificationCellsyntResource{}
+	_ resource.ResourceWithMoveState   = &NotificationCellsyntResource{}
 )
 
 // NewNotificationCellsyntResource returns a new instance of the Cellsynt notification resource.
@@ -308,3 +309,10 @@ func (*NotificationCellsyntResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationCellsyntResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Cellsynt{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationClicksendSmsResource{}
 	_ resource.ResourceWithImportState = &NotificationClicksendSmsResource{}
+	_ resource.ResourceWithMoveState   = &NotificationClicksendSmsResource{}
 )
 
 // NewNotificationClicksendSmsResource returns a new instance of the ClickSend SMS notification resource.
@@ -283,3 +284,10 @@ func (*NotificationClicksendSmsResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationClicksendSmsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.ClickSendSMS{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationDingDingResource{}
 	_ resource.ResourceWithImportState = &NotificationDingDingResource{}
+	_ resource.ResourceWithMoveState   = &NotificationDingDingResource{}
 )
 
 // NewNotificationDingDingResource returns a new instance of the DingDing notification resource.
@@ -275,3 +276,10 @@ func (*NotificationDingDingResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationDingDingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.DingDing{}.Type()),
+	}
+}
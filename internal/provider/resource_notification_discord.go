@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationDiscordResource{}
 	_ resource.ResourceWithImportState = &NotificationDiscordResource{}
+	_ resource.ResourceWithMoveState   = &NotificationDiscordResource{}
 )
 
 // NewNotificationDiscordResource returns a new instance of the Discord notification resource.
@@ -321,3 +322,10 @@ func (*NotificationDiscordResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationDiscordResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Discord{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationEvolutionResource{}
 	_ resource.ResourceWithImportState = &NotificationEvolutionResource{}
+	_ resource.ResourceWithMoveState   = &NotificationEvolutionResource{}
 )
 
 // NewNotificationEvolutionResource returns a new instance of the Evolution notification resource.
@@ -283,3 +284,10 @@ func (*NotificationEvolutionResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationEvolutionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Evolution{}.Type()),
+	}
+}
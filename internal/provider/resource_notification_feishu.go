@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationFeishuResource{}
 	_ resource.ResourceWithImportState = &NotificationFeishuResource{}
+	_ resource.ResourceWithMoveState   = &NotificationFeishuResource{}
 )
 
 // NewNotificationFeishuResource returns a new instance of the Feishu notification resource.
@@ -245,3 +246,10 @@ func (*NotificationFeishuResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationFeishuResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Feishu{}.Type()),
+	}
+}
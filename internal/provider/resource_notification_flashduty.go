@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationFlashDutyResource{}
 	_ resource.ResourceWithImportState = &NotificationFlashDutyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationFlashDutyResource{}
 )
 
 // NewNotificationFlashDutyResource returns a new instance of the FlashDuty notification resource.
@@ -263,3 +264,10 @@ func (*NotificationFlashDutyResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationFlashDutyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.FlashDuty{}.Type()),
+	}
+}
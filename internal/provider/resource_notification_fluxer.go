@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationFluxerResource{}
 	_ resource.ResourceWithImportState = &NotificationFluxerResource{}
+	_ resource.ResourceWithMoveState   = &NotificationFluxerResource{}
 )
 
 // NewNotificationFluxerResource returns a new instance of the Fluxer notification resource.
@@ -302,3 +303,10 @@ func fluxerFromModel(data *NotificationFluxerResourceModel) notification.Fluxer
 		},
 	}
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationFluxerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Fluxer{}.Type()),
+	}
+}
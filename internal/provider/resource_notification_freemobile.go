@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationFreemobileResource{}
 	_ resource.ResourceWithImportState = &NotificationFreemobileResource{}
+	_ resource.ResourceWithMoveState   = &NotificationFreemobileResource{}
 )
 
 // NewNotificationFreemobileResource returns a new instance of the Freemobile notification resource.
@@ -260,3 +261,10 @@ func (*NotificationFreemobileResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationFreemobileResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.FreeMobile{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationGoAlertResource{}
 	_ resource.ResourceWithImportState = &NotificationGoAlertResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGoAlertResource{}
 )
 
 // NewNotificationGoAlertResource returns a new instance of the GoAlert notification resource.
@@ -260,3 +261,10 @@ func (*NotificationGoAlertResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationGoAlertResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.GoAlert{}.Type()),
+	}
+}
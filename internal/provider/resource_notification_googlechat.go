@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationGoogleChatResource{}
 	_ resource.ResourceWithImportState = &NotificationGoogleChatResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGoogleChatResource{}
 )
 
 // NewNotificationGoogleChatResource returns a new instance of the Google Chat notification resource.
@@ -273,3 +274,10 @@ func (*NotificationGoogleChatResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationGoogleChatResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.GoogleChat{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationGoogleSheetsResource{}
 	_ resource.ResourceWithImportState = &NotificationGoogleSheetsResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGoogleSheetsResource{}
 )
 
 // NewNotificationGoogleSheetsResource returns a new instance of the Google Sheets notification resource.
@@ -253,3 +254,10 @@ func (*NotificationGoogleSheetsResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationGoogleSheetsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.GoogleSheets{}.Type()),
+	}
+}
@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationGorushResource{}
 	_ resource.ResourceWithImportState = &NotificationGorushResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGorushResource{}
 )
 
 // NewNotificationGorushResource returns a new instance of the Gorush notification resource.
@@ -319,3 +320,10 @@ func (*NotificationGorushResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationGorushResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Gorush{}.Type()),
+	}
+}
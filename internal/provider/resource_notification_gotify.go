@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationGotifyResource{}
 	_ resource.ResourceWithImportState = &NotificationGotifyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGotifyResource{}
 )
 
 // NewNotificationGotifyResource returns a new instance of the Gotify notification resource.
@@ -276,3 +277,10 @@ func (*NotificationGotifyResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationGotifyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Gotify{}.Type()),
+	}
+}
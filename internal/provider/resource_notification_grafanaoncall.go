@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationGrafanaOncallResource{}
 	_ resource.ResourceWithImportState = &NotificationGrafanaOncallResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGrafanaOncallResource{}
 )
 
 // NewNotificationGrafanaOncallResource returns a new instance of the Grafana OnCall notification resource.
@@ -249,3 +250,10 @@ func (*NotificationGrafanaOncallResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationGrafanaOncallResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.GrafanaOncall{}.Type()),
+	}
+}
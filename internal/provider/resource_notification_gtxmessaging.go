@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationGTXMessagingResource{}
 	_ resource.ResourceWithImportState = &NotificationGTXMessagingResource{}
+	_ resource.ResourceWithMoveState   = &NotificationGTXMessagingResource{}
 )
 
 // NewNotificationGTXMessagingResource returns a new instance of the GTX Messaging notification resource.
@@ -259,3 +260,10 @@ func (*NotificationGTXMessagingResource) ImportState(
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationGTXMessagingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.GTXMessaging{}.Type()),
+	}
+}
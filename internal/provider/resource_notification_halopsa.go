@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationHaloPSAResource{}
 	_ resource.ResourceWithImportState = &NotificationHaloPSAResource{}
+	_ resource.ResourceWithMoveState   = &NotificationHaloPSAResource{}
 )
 
 // NewNotificationHaloPSAResource returns a new instance of the HaloPSA notification resource.
@@ -274,3 +275,10 @@ func haloPSAFromModel(data *NotificationHaloPSAResourceModel) notification.HaloP
 		},
 	}
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationHaloPSAResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.HaloPSA{}.Type()),
+	}
+}
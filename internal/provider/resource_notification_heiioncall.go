@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationHeiiOnCallResource{}
 	_ resource.ResourceWithImportState = &NotificationHeiiOnCallResource{}
+	_ resource.ResourceWithMoveState   = &NotificationHeiiOnCallResource{}
 )
 
 // NewNotificationHeiiOnCallResource returns a new instance of the Heii On-Call notification
@@ -268,3 +269,10 @@ func (*NotificationHeiiOnCallResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationHeiiOnCallResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.HeiiOnCall{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationHomeAssistantResource{}
 	_ resource.ResourceWithImportState = &NotificationHomeAssistantResource{}
+	_ resource.ResourceWithMoveState   = &NotificationHomeAssistantResource{}
 )
 
 // NewNotificationHomeAssistantResource returns a new instance of the Home Assistant notification resource.
@@ -272,3 +273,10 @@ func (*NotificationHomeAssistantResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationHomeAssistantResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.HomeAssistant{}.Type()),
+	}
+}
@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationJiraServiceManagementResource{}
 	_ resource.ResourceWithImportState = &NotificationJiraServiceManagementResource{}
+	_ resource.ResourceWithMoveState   = &NotificationJiraServiceManagementResource{}
 )
 
 // NewNotificationJiraServiceManagementResource returns a new instance of the Jira Service Management
@@ -289,3 +290,10 @@ func (*NotificationJiraServiceManagementResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationJiraServiceManagementResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.JiraServiceManagement{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationKeepResource{}
 	_ resource.ResourceWithImportState = &NotificationKeepResource{}
+	_ resource.ResourceWithMoveState   = &NotificationKeepResource{}
 )
 
 // NewNotificationKeepResource returns a new instance of the Keep notification resource.
@@ -257,3 +258,10 @@ func (*NotificationKeepResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationKeepResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Keep{}.Type()),
+	}
+}
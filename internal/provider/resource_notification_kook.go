@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationKookResource{}
 	_ resource.ResourceWithImportState = &NotificationKookResource{}
+	_ resource.ResourceWithMoveState   = &NotificationKookResource{}
 )
 
 // NewNotificationKookResource returns a new instance of the Kook notification resource.
@@ -260,3 +261,10 @@ func (*NotificationKookResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationKookResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Kook{}.Type()),
+	}
+}
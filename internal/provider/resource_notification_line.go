@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationLineResource{}
 	_ resource.ResourceWithImportState = &NotificationLineResource{}
+	_ resource.ResourceWithMoveState   = &NotificationLineResource{}
 )
 
 // NewNotificationLineResource returns a new instance of the LINE notification resource.
@@ -260,3 +261,10 @@ func (*NotificationLineResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationLineResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Line{}.Type()),
+	}
+}
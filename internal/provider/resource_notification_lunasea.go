@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationLunaseaResource{}
 	_ resource.ResourceWithImportState = &NotificationLunaseaResource{}
+	_ resource.ResourceWithMoveState   = &NotificationLunaseaResource{}
 )
 
 // NewNotificationLunaseaResource returns a new instance of the Lunasea notification resource.
@@ -307,3 +308,10 @@ func (*NotificationLunaseaResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationLunaseaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.LunaSea{}.Type()),
+	}
+}
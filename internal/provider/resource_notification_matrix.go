@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationMatrixResource{}
 	_ resource.ResourceWithImportState = &NotificationMatrixResource{}
+	_ resource.ResourceWithMoveState   = &NotificationMatrixResource{}
 )
 
 // NewNotificationMatrixResource returns a new instance of the Matrix notification resource.
@@ -271,3 +272,10 @@ func (*NotificationMatrixResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationMatrixResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Matrix{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationMattermostResource{}
 	_ resource.ResourceWithImportState = &NotificationMattermostResource{}
+	_ resource.ResourceWithMoveState   = &NotificationMattermostResource{}
 )
 
 // NewNotificationMattermostResource returns a new instance of the Mattermost notification resource.
@@ -292,3 +293,10 @@ func (*NotificationMattermostResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationMattermostResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Mattermost{}.Type()),
+	}
+}
@@ -25,6 +25,7 @@ const maxDefaultAPIURL = "https://platform-api.max.ru"
 var (
 	_ resource.Resource                = &NotificationMaxResource{}
 	_ resource.ResourceWithImportState = &NotificationMaxResource{}
+	_ resource.ResourceWithMoveState   = &NotificationMaxResource{}
 )
 
 // NewNotificationMaxResource returns a new instance of the MAX messenger notification resource.
@@ -300,3 +301,10 @@ func maxFromModel(data *NotificationMaxResourceModel) notification.Max {
 		},
 	}
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationMaxResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Max{}.Type()),
+	}
+}
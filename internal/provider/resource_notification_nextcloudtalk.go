@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationNextcloudTalkResource{}
 	_ resource.ResourceWithImportState = &NotificationNextcloudTalkResource{}
+	_ resource.ResourceWithMoveState   = &NotificationNextcloudTalkResource{}
 )
 
 // NewNotificationNextcloudTalkResource returns a new instance of the Nextcloud Talk notification resource.
@@ -281,3 +282,10 @@ func (*NotificationNextcloudTalkResource) ImportState(
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationNextcloudTalkResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.NextcloudTalk{}.Type()),
+	}
+}
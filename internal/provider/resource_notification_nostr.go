@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationNostrResource{}
 	_ resource.ResourceWithImportState = &NotificationNostrResource{}
+	_ resource.ResourceWithMoveState   = &NotificationNostrResource{}
 )
 
 // NewNotificationNostrResource returns a new instance of the Nostr notification resource.
@@ -271,3 +272,10 @@ func (*NotificationNostrResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationNostrResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Nostr{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationNotiferyResource{}
 	_ resource.ResourceWithImportState = &NotificationNotiferyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationNotiferyResource{}
 )
 
 // NewNotificationNotiferyResource returns a new instance of the Notifery notification resource.
@@ -275,3 +276,10 @@ func (*NotificationNotiferyResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationNotiferyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Notifery{}.Type()),
+	}
+}
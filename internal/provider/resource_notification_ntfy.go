@@ -26,6 +26,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationNtfyResource{}
 	_ resource.ResourceWithImportState = &NotificationNtfyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationNtfyResource{}
 )
 
 func isValidURL(value string) bool {
@@ -341,3 +342,10 @@ func (*NotificationNtfyResource) ImportState(
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationNtfyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Ntfy{}.Type()),
+	}
+}
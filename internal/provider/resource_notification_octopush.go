@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationOctopushResource{}
 	_ resource.ResourceWithImportState = &NotificationOctopushResource{}
+	_ resource.ResourceWithMoveState   = &NotificationOctopushResource{}
 )
 
 // NewNotificationOctopushResource returns a new instance of the Octopush notification resource.
@@ -476,3 +477,10 @@ func (*NotificationOctopushResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationOctopushResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Octopush{}.Type()),
+	}
+}
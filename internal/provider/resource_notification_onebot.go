@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationOneBotResource{}
 	_ resource.ResourceWithImportState = &NotificationOneBotResource{}
+	_ resource.ResourceWithMoveState   = &NotificationOneBotResource{}
 )
 
 // NewNotificationOneBotResource returns a new instance of the OneBot notification resource.
@@ -274,3 +275,10 @@ func (*NotificationOneBotResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationOneBotResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.OneBot{}.Type()),
+	}
+}
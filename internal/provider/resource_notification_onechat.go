@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationOneChatResource{}
 	_ resource.ResourceWithImportState = &NotificationOneChatResource{}
+	_ resource.ResourceWithMoveState   = &NotificationOneChatResource{}
 )
 
 // NewNotificationOneChatResource returns a new instance of the OneChat notification resource.
@@ -267,3 +268,10 @@ func (*NotificationOneChatResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationOneChatResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.OneChat{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationOnesenderResource{}
 	_ resource.ResourceWithImportState = &NotificationOnesenderResource{}
+	_ resource.ResourceWithMoveState   = &NotificationOnesenderResource{}
 )
 
 // NewNotificationOnesenderResource returns a new instance of the OneSender notification resource.
@@ -283,3 +284,10 @@ func (*NotificationOnesenderResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationOnesenderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.OneSender{}.Type()),
+	}
+}
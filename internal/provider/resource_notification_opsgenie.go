@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationOpsgenieResource{}
 	_ resource.ResourceWithImportState = &NotificationOpsgenieResource{}
+	_ resource.ResourceWithMoveState   = &NotificationOpsgenieResource{}
 )
 
 // NewNotificationOpsgenieResource returns a new instance of the OpsGenie notification resource.
@@ -276,3 +277,10 @@ func (*NotificationOpsgenieResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationOpsgenieResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Opsgenie{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationPagerDutyResource{}
 	_ resource.ResourceWithImportState = &NotificationPagerDutyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPagerDutyResource{}
 )
 
 // NewNotificationPagerDutyResource returns a new instance of the PagerDuty notification resource.
@@ -296,3 +297,10 @@ func (*NotificationPagerDutyResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationPagerDutyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.PagerDuty{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationPagerTreeResource{}
 	_ resource.ResourceWithImportState = &NotificationPagerTreeResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPagerTreeResource{}
 )
 
 // NewNotificationPagerTreeResource returns a new instance of the PagerTree notification resource.
@@ -279,3 +280,10 @@ func (*NotificationPagerTreeResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationPagerTreeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.PagerTree{}.Type()),
+	}
+}
@@ -0,0 +1,469 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+var (
+	_ resource.Resource               = &NotificationPolicyResource{}
+	_ resource.ResourceWithModifyPlan = &NotificationPolicyResource{}
+)
+
+// NewNotificationPolicyResource returns a new instance of the NotificationPolicy resource.
+func NewNotificationPolicyResource() resource.Resource {
+	return &NotificationPolicyResource{}
+}
+
+// NotificationPolicyResource defines the resource implementation.
+type NotificationPolicyResource struct {
+	providerData *providerData
+}
+
+// NotificationPolicyResourceModel describes the NotificationPolicy resource data model.
+type NotificationPolicyResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	TagID           types.Int64  `tfsdk:"tag_id"`
+	TagName         types.String `tfsdk:"tag_name"`
+	TagValue        types.String `tfsdk:"tag_value"`
+	NotificationIDs types.List   `tfsdk:"notification_ids"`
+	MonitorIDs      types.List   `tfsdk:"monitor_ids"`
+}
+
+// Metadata returns the metadata for the resource.
+func (*NotificationPolicyResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_notification_policy"
+}
+
+// Schema returns the schema for the resource.
+func (*NotificationPolicyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attach notifications to all monitors with a tag. The monitors are matched on every " +
+			"plan, so monitors tagged later, e.g. in the Uptime Kuma UI or in the same apply as the policy, show " +
+			"up as drift and get the notifications on the next apply. Monitors, which lose the tag, and all " +
+			"matched monitors on destroy, get the notifications of the policy removed. The matched monitors must " +
+			"not manage these notifications in their `notification_ids` attribute.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Policy identifier in the format `<tag_id>` or `<tag_id>/<tag_value>`",
+				Computed:            true,
+			},
+			"tag_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the tag selecting the monitors. Exactly one of `tag_id` and `tag_name` " +
+					"must be set",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("tag_name")),
+				},
+			},
+			"tag_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the tag selecting the monitors",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tag_value": schema.StringAttribute{
+				MarkdownDescription: "Value of the tag. If not set, monitors with the tag are matched regardless of " +
+					"the value",
+				Optional: true,
+			},
+			"notification_ids": schema.ListAttribute{
+				MarkdownDescription: "List of notification IDs attached to the matched monitors",
+				ElementType:         types.Int64Type,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"monitor_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the monitors matched by the tag. Monitors, which miss notifications of " +
+					"the policy, are only listed after the next apply",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the API client.
+func (r *NotificationPolicyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	r.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan resolves the tag and checks the monitors currently matched by
+// the tag. A monitor, which is missing notifications of the policy, is not
+// part of the state and therefore causes a diff. The matched monitors are
+// only known after apply, because monitors might be tagged in the same apply.
+// Such a monitor is picked up by the next plan.
+func (r *NotificationPolicyResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to plan on destroy or before the provider is configured.
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data NotificationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || (!isKnown(data.TagID) && !isKnown(data.TagName)) {
+		return
+	}
+
+	selected, found := r.resolveTag(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || !found {
+		// The tag might be created in the same apply.
+		return
+	}
+
+	data.TagID = types.Int64Value(selected.ID)
+	data.TagName = types.StringValue(selected.Name)
+	data.ID = types.StringValue(notificationPolicyID(selected.ID, data.TagValue))
+
+	if req.State.Raw.IsNull() || !req.Plan.Raw.Equal(req.State.Raw) {
+		data.MonitorIDs = types.ListUnknown(types.Int64Type)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+
+		return
+	}
+
+	monitors, err := r.providerData.client.GetMonitors(ctx)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read monitors", err.Error())
+		return
+	}
+
+	if notificationPolicyMonitorIDs(monitors, selected.ID, data.TagValue, nil).Equal(data.MonitorIDs) {
+		return
+	}
+
+	data.MonitorIDs = types.ListUnknown(types.Int64Type)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+// Create creates a new resource.
+func (r *NotificationPolicyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data NotificationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the current state of the resource.
+func (r *NotificationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NotificationPolicyResourceModel
+
+	// Get resource from state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selected, found := r.resolveTag(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	monitors, err := r.providerData.client.GetMonitors(ctx)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read monitors", err.Error())
+		return
+	}
+
+	var notificationIDs []int64
+	resp.Diagnostics.Append(data.NotificationIDs.ElementsAs(ctx, &notificationIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagID = types.Int64Value(selected.ID)
+	data.TagName = types.StringValue(selected.Name)
+	data.ID = types.StringValue(notificationPolicyID(selected.ID, data.TagValue))
+	data.MonitorIDs = notificationPolicyMonitorIDs(monitors, selected.ID, data.TagValue, notificationIDs)
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource.
+func (r *NotificationPolicyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data, state NotificationPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &data, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource.
+func (r *NotificationPolicyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data NotificationPolicyResourceModel
+
+	// Get resource from state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var monitorIDs, notificationIDs []int64
+	resp.Diagnostics.Append(data.MonitorIDs.ElementsAs(ctx, &monitorIDs, false)...)
+	resp.Diagnostics.Append(data.NotificationIDs.ElementsAs(ctx, &notificationIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, monitorID := range monitorIDs {
+		err := r.providerData.updateMonitorNotifications(ctx, monitorID, nil, notificationIDs)
+		// Handle error.
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("failed to remove notifications from monitor %d", monitorID),
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// resolveTag returns the tag selected by the tag ID or, if the ID is not
+// known, by the name. The second return value is false, if the tag does not
+// exist.
+func (r *NotificationPolicyResource) resolveTag(
+	ctx context.Context,
+	data NotificationPolicyResourceModel,
+	diags *diag.Diagnostics,
+) (tag.Tag, bool) {
+	tags, err := r.providerData.client.GetTags(ctx)
+	// Handle error.
+	if err != nil {
+		diags.AddError("failed to read tags", err.Error())
+		return tag.Tag{}, false
+	}
+
+	return findTag(tags, data.TagID, data.TagName, diags)
+}
+
+// reconcile attaches the notifications of the policy to all monitors matched
+// by the tag. On update, the notifications removed from the policy are
+// detached from the matched monitors and all notifications of the prior
+// state are detached from the monitors, which are no longer matched.
+func (r *NotificationPolicyResource) reconcile(
+	ctx context.Context,
+	data *NotificationPolicyResourceModel,
+	state *NotificationPolicyResourceModel,
+	diags *diag.Diagnostics,
+) {
+	selected, found := r.resolveTag(ctx, *data, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if !found {
+		diags.AddError(
+			"Unknown Tag",
+			fmt.Sprintf("Tag %s does not exist.", notificationPolicyTagLabel(*data)),
+		)
+		return
+	}
+
+	monitors, err := r.providerData.client.GetMonitors(ctx)
+	// Handle error.
+	if err != nil {
+		diags.AddError("failed to read monitors", err.Error())
+		return
+	}
+
+	var notificationIDs, oldNotificationIDs, oldMonitorIDs []int64
+	diags.Append(data.NotificationIDs.ElementsAs(ctx, &notificationIDs, false)...)
+	if state != nil {
+		diags.Append(state.NotificationIDs.ElementsAs(ctx, &oldNotificationIDs, false)...)
+		diags.Append(state.MonitorIDs.ElementsAs(ctx, &oldMonitorIDs, false)...)
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	monitorIDs := notificationPolicyMatchingMonitors(monitors, selected.ID, data.TagValue)
+
+	removed := slices.DeleteFunc(slices.Clone(oldNotificationIDs), func(id int64) bool {
+		return slices.Contains(notificationIDs, id)
+	})
+
+	for _, monitorID := range monitorIDs {
+		err := r.providerData.updateMonitorNotifications(ctx, monitorID, notificationIDs, removed)
+		// Handle error.
+		if err != nil {
+			diags.AddError(fmt.Sprintf("failed to add notifications to monitor %d", monitorID), err.Error())
+			return
+		}
+	}
+
+	for _, monitorID := range oldMonitorIDs {
+		if slices.Contains(monitorIDs, monitorID) {
+			continue
+		}
+
+		err := r.providerData.updateMonitorNotifications(ctx, monitorID, nil, oldNotificationIDs)
+		// Handle error.
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+
+			diags.AddError(fmt.Sprintf("failed to remove notifications from monitor %d", monitorID), err.Error())
+			return
+		}
+	}
+
+	data.TagID = types.Int64Value(selected.ID)
+	data.TagName = types.StringValue(selected.Name)
+	data.ID = types.StringValue(notificationPolicyID(selected.ID, data.TagValue))
+
+	monitorIDList, d := types.ListValueFrom(ctx, types.Int64Type, monitorIDs)
+	diags.Append(d...)
+
+	data.MonitorIDs = monitorIDList
+}
+
+// notificationPolicyMatchingMonitors returns the sorted IDs of the monitors
+// with the tag. A null value matches the tag regardless of its value.
+func notificationPolicyMatchingMonitors(monitors []monitor.Base, tagID int64, value types.String) []int64 {
+	monitorIDs := []int64{}
+
+	for _, mon := range monitors {
+		if slices.ContainsFunc(mon.Tags, func(t tag.MonitorTag) bool {
+			return t.TagID == tagID && (value.IsNull() || t.Value == value.ValueString())
+		}) {
+			monitorIDs = append(monitorIDs, mon.ID)
+		}
+	}
+
+	slices.Sort(monitorIDs)
+
+	return monitorIDs
+}
+
+// notificationPolicyMonitorIDs returns the list of the monitors with the tag,
+// which have all the given notifications attached.
+func notificationPolicyMonitorIDs(
+	monitors []monitor.Base,
+	tagID int64,
+	value types.String,
+	notificationIDs []int64,
+) types.List {
+	monitorIDs := notificationPolicyMatchingMonitors(monitors, tagID, value)
+
+	monitorIDs = slices.DeleteFunc(monitorIDs, func(monitorID int64) bool {
+		idx := slices.IndexFunc(monitors, func(mon monitor.Base) bool { return mon.ID == monitorID })
+		return slices.ContainsFunc(notificationIDs, func(id int64) bool {
+			return !slices.Contains(monitors[idx].NotificationIDs, id)
+		})
+	})
+
+	elements := make([]attr.Value, 0, len(monitorIDs))
+	for _, id := range monitorIDs {
+		elements = append(elements, types.Int64Value(id))
+	}
+
+	return types.ListValueMust(types.Int64Type, elements)
+}
+
+// notificationPolicyID returns the identifier of the policy for the tag and
+// the optional value.
+func notificationPolicyID(tagID int64, value types.String) string {
+	if value.IsNull() {
+		return fmt.Sprintf("%d", tagID)
+	}
+
+	return fmt.Sprintf("%d/%s", tagID, value.ValueString())
+}
+
+// notificationPolicyTagLabel returns a human readable reference of the tag.
+func notificationPolicyTagLabel(data NotificationPolicyResourceModel) string {
+	if isKnown(data.TagID) {
+		return fmt.Sprintf("with ID %d", data.TagID.ValueInt64())
+	}
+
+	return fmt.Sprintf("with name %q", data.TagName.ValueString())
+}
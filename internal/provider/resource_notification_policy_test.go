@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+func TestNotificationPolicyMonitorIDs(t *testing.T) {
+	monitors := []monitor.Base{
+		{ID: 4, Tags: []tag.MonitorTag{{TagID: 1, Value: "1"}}, NotificationIDs: []int64{7}},
+		{ID: 2, Tags: []tag.MonitorTag{{TagID: 1, Value: "1"}}, NotificationIDs: []int64{7, 8}},
+		{ID: 3, Tags: []tag.MonitorTag{{TagID: 1, Value: "2"}}, NotificationIDs: []int64{7, 8}},
+		{ID: 5, Tags: []tag.MonitorTag{{TagID: 2, Value: "1"}}, NotificationIDs: []int64{7, 8}},
+		{ID: 6},
+	}
+
+	ids := func(values ...int64) types.List {
+		elements := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elements = append(elements, types.Int64Value(v))
+		}
+
+		return types.ListValueMust(types.Int64Type, elements)
+	}
+
+	tests := []struct {
+		name            string
+		value           types.String
+		notificationIDs []int64
+		wantMatching    []int64
+		want            types.List
+	}{
+		{
+			name:         "any value",
+			value:        types.StringNull(),
+			wantMatching: []int64{2, 3, 4},
+			want:         ids(2, 3, 4),
+		},
+		{
+			name:            "any value with notifications",
+			value:           types.StringNull(),
+			notificationIDs: []int64{7, 8},
+			wantMatching:    []int64{2, 3, 4},
+			want:            ids(2, 3),
+		},
+		{
+			name:            "value",
+			value:           types.StringValue("1"),
+			notificationIDs: []int64{8},
+			wantMatching:    []int64{2, 4},
+			want:            ids(2),
+		},
+		{
+			name:         "no match",
+			value:        types.StringValue("3"),
+			wantMatching: []int64{},
+			want:         ids(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matching := notificationPolicyMatchingMonitors(monitors, 1, tt.value)
+			if !slices.Equal(matching, tt.wantMatching) {
+				t.Fatalf("notificationPolicyMatchingMonitors() = %v, want %v", matching, tt.wantMatching)
+			}
+
+			got := notificationPolicyMonitorIDs(monitors, 1, tt.value, tt.notificationIDs)
+			if !got.Equal(tt.want) {
+				t.Fatalf("notificationPolicyMonitorIDs() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationPolicyID(t *testing.T) {
+	if got := notificationPolicyID(3, types.StringNull()); got != "3" {
+		t.Errorf("notificationPolicyID(3, null) = %q, want %q", got, "3")
+	}
+
+	if got := notificationPolicyID(3, types.StringValue("1")); got != "3/1" {
+		t.Errorf("notificationPolicyID(3, \"1\") = %q, want %q", got, "3/1")
+	}
+}
+
+func TestAccNotificationPolicyResource(t *testing.T) {
+	tagName := acctest.RandomWithPrefix("TestNotificationPolicyTier")
+	notificationName := acctest.RandomWithPrefix("TestNotificationPolicyWebhook")
+	monitorName := acctest.RandomWithPrefix("TestNotificationPolicyMonitor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationPolicyResourceConfig(tagName, notificationName, monitorName, 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_notification_policy.test",
+						tfjsonpath.New("monitor_ids"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_notification_policy.test",
+						tfjsonpath.New("tag_name"),
+						knownvalue.StringExact(tagName),
+					),
+				},
+			},
+			{
+				Config: testAccNotificationPolicyResourceConfig(tagName, notificationName, monitorName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// A second monitor with the tag, created in the same apply, is
+				// picked up by the next plan.
+				Config: testAccNotificationPolicyResourceConfig(tagName, notificationName, monitorName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"uptimekuma_notification_policy.test",
+							plancheck.ResourceActionUpdate,
+						),
+						plancheck.ExpectUnknownValue(
+							"uptimekuma_notification_policy.test",
+							tfjsonpath.New("monitor_ids"),
+						),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccNotificationPolicyResourceConfig(tagName, notificationName, monitorName, 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_notification_policy.test",
+						tfjsonpath.New("monitor_ids"),
+						knownvalue.ListSizeExact(2),
+					),
+				},
+			},
+			{
+				Config: testAccNotificationPolicyResourceConfig(tagName, notificationName, monitorName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccNotificationPolicyResourceConfig(
+	tagName string,
+	notificationName string,
+	monitorName string,
+	monitorCount int,
+) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_tag" "tier" {
+  name  = %[1]q
+  color = "#6b7280"
+}
+
+resource "uptimekuma_notification_webhook" "test" {
+  name                 = %[2]q
+  webhook_url          = "https://example.com/webhook"
+  webhook_content_type = "json"
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  count = %[4]d
+
+  name = "%[3]s-${count.index}"
+  url  = "https://example.com"
+
+  tags = [
+    {
+      tag_id = uptimekuma_tag.tier.id
+      value  = "1"
+    },
+  ]
+}
+
+resource "uptimekuma_notification_policy" "test" {
+  tag_id           = uptimekuma_tag.tier.id
+  tag_value        = "1"
+  notification_ids = [uptimekuma_notification_webhook.test.id]
+
+  depends_on = [uptimekuma_monitor_http.test]
+}
+`, tagName, notificationName, monitorName, monitorCount)
+}
@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationPromoSMSResource{}
 	_ resource.ResourceWithImportState = &NotificationPromoSMSResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPromoSMSResource{}
 )
 
 // NewNotificationPromoSMSResource returns a new instance of the PromoSMS notification resource.
@@ -304,3 +305,10 @@ func (*NotificationPromoSMSResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationPromoSMSResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.PromoSMS{}.Type()),
+	}
+}
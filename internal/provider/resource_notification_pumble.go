@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationPumbleResource{}
 	_ resource.ResourceWithImportState = &NotificationPumbleResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPumbleResource{}
 )
 
 // NewNotificationPumbleResource returns a new instance of the Pumble notification resource.
@@ -249,3 +250,10 @@ func (*NotificationPumbleResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationPumbleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Pumble{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationPushbulletResource{}
 	_ resource.ResourceWithImportState = &NotificationPushbulletResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPushbulletResource{}
 )
 
 // NewNotificationPushbulletResource returns a new instance of the Pushbullet notification resource.
@@ -249,3 +250,10 @@ func (*NotificationPushbulletResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationPushbulletResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Pushbullet{}.Type()),
+	}
+}
@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationPushDeerResource{}
 	_ resource.ResourceWithImportState = &NotificationPushDeerResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPushDeerResource{}
 )
 
 // NewNotificationPushDeerResource returns a new instance of the PushDeer notification resource.
@@ -252,3 +253,10 @@ func (*NotificationPushDeerResource) ImportState(
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationPushDeerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.PushDeer{}.Type()),
+	}
+}
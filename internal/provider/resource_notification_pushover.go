@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationPushoverResource{}
 	_ resource.ResourceWithImportState = &NotificationPushoverResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPushoverResource{}
 )
 
 // NewNotificationPushoverResource returns a new instance of the Pushover notification resource.
@@ -339,3 +340,10 @@ func (*NotificationPushoverResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationPushoverResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Pushover{}.Type()),
+	}
+}
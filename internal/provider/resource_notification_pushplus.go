@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationPushPlusResource{}
 	_ resource.ResourceWithImportState = &NotificationPushPlusResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPushPlusResource{}
 )
 
 // NewNotificationPushPlusResource returns a new instance of the PushPlus notification resource.
@@ -249,3 +250,10 @@ func (*NotificationPushPlusResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationPushPlusResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.PushPlus{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationPushyResource{}
 	_ resource.ResourceWithImportState = &NotificationPushyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationPushyResource{}
 )
 
 // NewNotificationPushyResource returns a new instance of the Pushy notification resource.
@@ -257,3 +258,10 @@ func (*NotificationPushyResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationPushyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Pushy{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationResendResource{}
 	_ resource.ResourceWithImportState = &NotificationResendResource{}
+	_ resource.ResourceWithMoveState   = &NotificationResendResource{}
 )
 
 // NewNotificationResendResource returns a new instance of the Resend notification resource.
@@ -299,3 +300,10 @@ func resendFromModel(data *NotificationResendResourceModel) notification.Resend
 		},
 	}
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationResendResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Resend{}.Type()),
+	}
+}
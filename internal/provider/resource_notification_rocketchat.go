@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationRocketChatResource{}
 	_ resource.ResourceWithImportState = &NotificationRocketChatResource{}
+	_ resource.ResourceWithMoveState   = &NotificationRocketChatResource{}
 )
 
 // NewNotificationRocketChatResource returns a new instance of the RocketChat notification resource.
@@ -292,3 +293,10 @@ func (*NotificationRocketChatResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationRocketChatResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.RocketChat{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSendgridResource{}
 	_ resource.ResourceWithImportState = &NotificationSendgridResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSendgridResource{}
 )
 
 // NewNotificationSendgridResource returns a new instance of the SendGrid notification resource.
@@ -308,3 +309,10 @@ func (*NotificationSendgridResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSendgridResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SendGrid{}.Type()),
+	}
+}
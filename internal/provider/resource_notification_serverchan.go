@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationServerChanResource{}
 	_ resource.ResourceWithImportState = &NotificationServerChanResource{}
+	_ resource.ResourceWithMoveState   = &NotificationServerChanResource{}
 )
 
 // NewNotificationServerChanResource returns a new instance of the ServerChan notification resource.
@@ -249,3 +250,10 @@ func (*NotificationServerChanResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationServerChanResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.ServerChan{}.Type()),
+	}
+}
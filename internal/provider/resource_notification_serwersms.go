@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSerwersmsResource{}
 	_ resource.ResourceWithImportState = &NotificationSerwersmsResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSerwersmsResource{}
 )
 
 // NewNotificationSerwersmsResource returns a new instance of the SerwerSMS notification resource.
@@ -293,3 +294,10 @@ func (*NotificationSerwersmsResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSerwersmsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SerwerSMS{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSevenioResource{}
 	_ resource.ResourceWithImportState = &NotificationSevenioResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSevenioResource{}
 )
 
 // NewNotificationSevenioResource returns a new instance of the Sevenio notification resource.
@@ -274,3 +275,10 @@ func (*NotificationSevenioResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSevenioResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SevenIO{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSignalResource{}
 	_ resource.ResourceWithImportState = &NotificationSignalResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSignalResource{}
 )
 
 // NewNotificationSignalResource returns a new instance of the Signal notification resource.
@@ -265,3 +266,10 @@ func (*NotificationSignalResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSignalResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Signal{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSIGNL4Resource{}
 	_ resource.ResourceWithImportState = &NotificationSIGNL4Resource{}
+	_ resource.ResourceWithMoveState   = &NotificationSIGNL4Resource{}
 )
 
 // NewNotificationSIGNL4Resource returns a new instance of the SIGNL4 notification resource.
@@ -249,3 +250,10 @@ func (*NotificationSIGNL4Resource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSIGNL4Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SIGNL4{}.Type()),
+	}
+}
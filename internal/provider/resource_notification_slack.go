@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSlackResource{}
 	_ resource.ResourceWithImportState = &NotificationSlackResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSlackResource{}
 )
 
 // NewNotificationSlackResource returns a new instance of the Slack notification resource.
@@ -299,3 +300,10 @@ func (*NotificationSlackResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSlackResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Slack{}.Type()),
+	}
+}
@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSMSCResource{}
 	_ resource.ResourceWithImportState = &NotificationSMSCResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSMSCResource{}
 )
 
 // NewNotificationSMSCResource returns a new instance of the SMSC notification resource.
@@ -296,3 +297,10 @@ func (*NotificationSMSCResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSMSCResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SMSC{}.Type()),
+	}
+}
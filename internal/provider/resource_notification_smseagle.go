@@ -25,6 +25,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSMSEagleResource{}
 	_ resource.ResourceWithImportState = &NotificationSMSEagleResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSMSEagleResource{}
 )
 
 // NewNotificationSMSEagleResource returns a new instance of the SMSEagle notification resource.
@@ -423,3 +424,10 @@ func (*NotificationSMSEagleResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSMSEagleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SMSEagle{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSMSIRResource{}
 	_ resource.ResourceWithImportState = &NotificationSMSIRResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSMSIRResource{}
 )
 
 // NewNotificationSMSIRResource returns a new instance of the SMS.ir notification resource.
@@ -275,3 +276,10 @@ func smsirFromModel(data *NotificationSMSIRResourceModel) notification.SMSIR {
 		},
 	}
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSMSIRResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SMSIR{}.Type()),
+	}
+}
@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSMSManagerResource{}
 	_ resource.ResourceWithImportState = &NotificationSMSManagerResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSMSManagerResource{}
 )
 
 // NewNotificationSMSManagerResource returns a new instance of the SMS Manager notification resource.
@@ -275,3 +276,10 @@ func (*NotificationSMSManagerResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSMSManagerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SMSManager{}.Type()),
+	}
+}
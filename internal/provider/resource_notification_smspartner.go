@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSMSPartnerResource{}
 	_ resource.ResourceWithImportState = &NotificationSMSPartnerResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSMSPartnerResource{}
 )
 
 // NewNotificationSMSPartnerResource returns a new instance of the SMS Partner notification resource.
@@ -276,3 +277,10 @@ func (*NotificationSMSPartnerResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSMSPartnerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SMSPartner{}.Type()),
+	}
+}
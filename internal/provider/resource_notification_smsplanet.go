@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSMSPlanetResource{}
 	_ resource.ResourceWithImportState = &NotificationSMSPlanetResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSMSPlanetResource{}
 )
 
 // NewNotificationSMSPlanetResource returns a new instance of the SMS Planet notification resource.
@@ -276,3 +277,10 @@ func (*NotificationSMSPlanetResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSMSPlanetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SMSPlanet{}.Type()),
+	}
+}
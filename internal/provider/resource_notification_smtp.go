@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSMTPResource{}
 	_ resource.ResourceWithImportState = &NotificationSMTPResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSMTPResource{}
 )
 
 // NewNotificationSMTPResource returns a new instance of the SMTP notification resource.
@@ -457,3 +458,10 @@ func populateSMTPModelFromAPI(
 
 	data.HTMLBody = types.BoolValue(smtp.HTMLBody)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSMTPResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SMTP{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSplunkResource{}
 	_ resource.ResourceWithImportState = &NotificationSplunkResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSplunkResource{}
 )
 
 // NewNotificationSplunkResource returns a new instance of the Splunk notification resource.
@@ -283,3 +284,10 @@ func (*NotificationSplunkResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSplunkResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Splunk{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSpugPushResource{}
 	_ resource.ResourceWithImportState = &NotificationSpugPushResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSpugPushResource{}
 )
 
 // NewNotificationSpugPushResource returns a new instance of the SpugPush notification resource.
@@ -251,3 +252,10 @@ func (*NotificationSpugPushResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSpugPushResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.SpugPush{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationSquadcastResource{}
 	_ resource.ResourceWithImportState = &NotificationSquadcastResource{}
+	_ resource.ResourceWithMoveState   = &NotificationSquadcastResource{}
 )
 
 // NewNotificationSquadcastResource returns a new instance of the Squadcast notification resource.
@@ -249,3 +250,10 @@ func (*NotificationSquadcastResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationSquadcastResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Squadcast{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationStackfieldResource{}
 	_ resource.ResourceWithImportState = &NotificationStackfieldResource{}
+	_ resource.ResourceWithMoveState   = &NotificationStackfieldResource{}
 )
 
 // NewNotificationStackfieldResource returns a new instance of the Stackfield notification resource.
@@ -249,3 +250,10 @@ func (*NotificationStackfieldResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationStackfieldResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Stackfield{}.Type()),
+	}
+}
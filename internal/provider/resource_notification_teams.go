@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationTeamsResource{}
 	_ resource.ResourceWithImportState = &NotificationTeamsResource{}
+	_ resource.ResourceWithMoveState   = &NotificationTeamsResource{}
 )
 
 // NewNotificationTeamsResource returns a new instance of the Teams notification resource.
@@ -243,3 +244,10 @@ func (*NotificationTeamsResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationTeamsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Teams{}.Type()),
+	}
+}
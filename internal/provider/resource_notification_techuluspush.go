@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationTechulusPushResource{}
 	_ resource.ResourceWithImportState = &NotificationTechulusPushResource{}
+	_ resource.ResourceWithMoveState   = &NotificationTechulusPushResource{}
 )
 
 // NewNotificationTechulusPushResource returns a new instance of the TechulusPush notification resource.
@@ -302,3 +303,10 @@ func (*NotificationTechulusPushResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationTechulusPushResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.TechulusPush{}.Type()),
+	}
+}
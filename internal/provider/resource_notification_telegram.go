@@ -25,6 +25,7 @@ const telegramDefaultServerURL = "https://api.telegram.org"
 var (
 	_ resource.Resource                = &NotificationTelegramResource{}
 	_ resource.ResourceWithImportState = &NotificationTelegramResource{}
+	_ resource.ResourceWithMoveState   = &NotificationTelegramResource{}
 )
 
 // NewNotificationTelegramResource returns a new instance of the Telegram notification resource.
@@ -351,3 +352,10 @@ func (*NotificationTelegramResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationTelegramResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Telegram{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationTelnyxResource{}
 	_ resource.ResourceWithImportState = &NotificationTelnyxResource{}
+	_ resource.ResourceWithMoveState   = &NotificationTelnyxResource{}
 )
 
 // NewNotificationTelnyxResource returns a new instance of the Telnyx notification resource.
@@ -281,3 +282,10 @@ func telnyxFromModel(data *NotificationTelnyxResourceModel) notification.Telnyx
 		},
 	}
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationTelnyxResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Telnyx{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationTeltonikaResource{}
 	_ resource.ResourceWithImportState = &NotificationTeltonikaResource{}
+	_ resource.ResourceWithMoveState   = &NotificationTeltonikaResource{}
 )
 
 // NewNotificationTeltonikaResource returns a new instance of the Teltonika notification resource.
@@ -315,3 +316,10 @@ func teltonikaFromModel(data *NotificationTeltonikaResourceModel) notification.T
 		},
 	}
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationTeltonikaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Teltonika{}.Type()),
+	}
+}
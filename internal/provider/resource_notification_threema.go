@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationThreemaResource{}
 	_ resource.ResourceWithImportState = &NotificationThreemaResource{}
+	_ resource.ResourceWithMoveState   = &NotificationThreemaResource{}
 )
 
 // NewNotificationThreemaResource returns a new instance of the Threema notification resource.
@@ -283,3 +284,10 @@ func (*NotificationThreemaResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationThreemaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Threema{}.Type()),
+	}
+}
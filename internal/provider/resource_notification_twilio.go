@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationTwilioResource{}
 	_ resource.ResourceWithImportState = &NotificationTwilioResource{}
+	_ resource.ResourceWithMoveState   = &NotificationTwilioResource{}
 )
 
 // NewNotificationTwilioResource returns a new instance of the Twilio notification resource.
@@ -297,3 +298,10 @@ func (*NotificationTwilioResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationTwilioResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Twilio{}.Type()),
+	}
+}
@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationVKResource{}
 	_ resource.ResourceWithImportState = &NotificationVKResource{}
+	_ resource.ResourceWithMoveState   = &NotificationVKResource{}
 )
 
 // NewNotificationVKResource returns a new instance of the VK notification resource.
@@ -278,3 +279,10 @@ func vkFromModel(data *NotificationVKResourceModel) notification.VK {
 		},
 	}
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationVKResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.VK{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationWAHAResource{}
 	_ resource.ResourceWithImportState = &NotificationWAHAResource{}
+	_ resource.ResourceWithMoveState   = &NotificationWAHAResource{}
 )
 
 // NewNotificationWAHAResource returns a new instance of the WAHA notification resource.
@@ -282,3 +283,10 @@ func (*NotificationWAHAResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationWAHAResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.WAHA{}.Type()),
+	}
+}
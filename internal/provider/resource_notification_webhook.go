@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationWebhookResource{}
 	_ resource.ResourceWithImportState = &NotificationWebhookResource{}
+	_ resource.ResourceWithMoveState   = &NotificationWebhookResource{}
 )
 
 // NewNotificationWebhookResource returns a new instance of the Webhook notification resource.
@@ -314,3 +315,10 @@ func (*NotificationWebhookResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationWebhookResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Webhook{}.Type()),
+	}
+}
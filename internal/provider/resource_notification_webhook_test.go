@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccNotificationWebhookResource(t *testing.T) {
@@ -193,3 +194,52 @@ resource "uptimekuma_notification_webhook" "test" {
 }
 `, name, webhookURL)
 }
+
+func TestAccNotificationWebhookWriteOnly(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestNotificationWebhookWriteOnly")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationWebhookWriteOnlyConfig(name, "https://example.com/hook-1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptimekuma_notification_webhook.test", "name", name),
+					resource.TestCheckNoResourceAttr("uptimekuma_notification_webhook.test", "webhook_url"),
+					resource.TestCheckNoResourceAttr("uptimekuma_notification_webhook.test", "webhook_url_wo"),
+					resource.TestCheckResourceAttr(
+						"uptimekuma_notification_webhook.test",
+						"webhook_url_wo_version",
+						"1",
+					),
+				),
+			},
+			{
+				Config: testAccNotificationWebhookWriteOnlyConfig(name, "https://example.com/hook-2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("uptimekuma_notification_webhook.test", "webhook_url"),
+					resource.TestCheckResourceAttr(
+						"uptimekuma_notification_webhook.test",
+						"webhook_url_wo_version",
+						"2",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationWebhookWriteOnlyConfig(name string, webhookURL string, version int) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_notification_webhook" "test" {
+  name                   = %[1]q
+  webhook_url_wo         = %[2]q
+  webhook_url_wo_version = %[3]d
+  webhook_content_type   = "json"
+}
+`, name, webhookURL, version)
+}
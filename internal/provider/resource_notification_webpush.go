@@ -24,6 +24,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationWebpushResource{}
 	_ resource.ResourceWithImportState = &NotificationWebpushResource{}
+	_ resource.ResourceWithMoveState   = &NotificationWebpushResource{}
 )
 
 // NewNotificationWebpushResource returns a new instance of the Web Push notification resource.
@@ -374,3 +375,10 @@ func flattenWebpushSubscription(
 
 	return subscriptionObj
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationWebpushResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Webpush{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationWeComResource{}
 	_ resource.ResourceWithImportState = &NotificationWeComResource{}
+	_ resource.ResourceWithMoveState   = &NotificationWeComResource{}
 )
 
 // NewNotificationWeComResource returns a new instance of the WeCom notification resource.
@@ -249,3 +250,10 @@ func (*NotificationWeComResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationWeComResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.WeCom{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationWhapiResource{}
 	_ resource.ResourceWithImportState = &NotificationWhapiResource{}
+	_ resource.ResourceWithMoveState   = &NotificationWhapiResource{}
 )
 
 // NewNotificationWhapiResource returns a new instance of the Whapi notification resource.
@@ -272,3 +273,10 @@ func (*NotificationWhapiResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationWhapiResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Whapi{}.Type()),
+	}
+}
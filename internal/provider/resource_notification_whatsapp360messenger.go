@@ -22,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationWhatsapp360messengerResource{}
 	_ resource.ResourceWithImportState = &NotificationWhatsapp360messengerResource{}
+	_ resource.ResourceWithMoveState   = &NotificationWhatsapp360messengerResource{}
 )
 
 // NewNotificationWhatsapp360messengerResource returns a new instance of the WhatsApp 360messenger
@@ -341,3 +342,10 @@ func whatsapp360messengerFromModel(
 
 	return whatsapp360messenger
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationWhatsapp360messengerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.Whatsapp360messenger{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationWPushResource{}
 	_ resource.ResourceWithImportState = &NotificationWPushResource{}
+	_ resource.ResourceWithMoveState   = &NotificationWPushResource{}
 )
 
 // NewNotificationWPushResource returns a new instance of the WPush notification resource.
@@ -264,3 +265,10 @@ func (*NotificationWPushResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationWPushResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.WPush{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationYZJResource{}
 	_ resource.ResourceWithImportState = &NotificationYZJResource{}
+	_ resource.ResourceWithMoveState   = &NotificationYZJResource{}
 )
 
 // NewNotificationYZJResource returns a new instance of the YZJ notification resource.
@@ -266,3 +267,10 @@ func (*NotificationYZJResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationYZJResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.YZJ{}.Type()),
+	}
+}
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &NotificationZohoCliqResource{}
 	_ resource.ResourceWithImportState = &NotificationZohoCliqResource{}
+	_ resource.ResourceWithMoveState   = &NotificationZohoCliqResource{}
 )
 
 // NewNotificationZohoCliqResource returns a new instance of the Zoho Cliq notification resource.
@@ -251,3 +252,10 @@ func (*NotificationZohoCliqResource) ImportState(
 	// Populate state.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// MoveState moves the state of a generic notification of the same type into this resource.
+func (*NotificationZohoCliqResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		moveGenericNotificationState(ctx, notification.ZohoCliq{}.Type()),
+	}
+}
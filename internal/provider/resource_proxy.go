@@ -3,9 +3,12 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -18,7 +21,10 @@ import (
 	"github.com/breml/go-uptime-kuma-client/proxy"
 )
 
-var _ resource.Resource = &ProxyResource{}
+var (
+	_ resource.Resource                = &ProxyResource{}
+	_ resource.ResourceWithImportState = &ProxyResource{}
+)
 
 // NewProxyResource returns a new instance of the proxy resource.
 func NewProxyResource() resource.Resource {
@@ -282,3 +288,23 @@ func (r *ProxyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 }
+
+// ImportState imports an existing resource by ID.
+func (*ProxyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be a valid integer, got: %s", req.ID),
+		)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
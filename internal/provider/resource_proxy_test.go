@@ -82,6 +82,12 @@ func TestAccProxyResource(t *testing.T) {
 					),
 				},
 			},
+			{
+				ResourceName:            "uptimekuma_proxy.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_existing"},
+			},
 		},
 	})
 }
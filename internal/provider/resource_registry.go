@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/notification"
+)
+
+// monitorResourcesByType maps the Uptime Kuma monitor type identifier to the
+// constructor of the resource managing monitors of that type.
+func monitorResourcesByType() map[string]func() resource.Resource {
+	return map[string]func() resource.Resource{
+		monitor.DNS{}.Type():              NewMonitorDNSResource,
+		monitor.Docker{}.Type():           NewMonitorDockerResource,
+		monitor.GameDig{}.Type():          NewMonitorGameDigResource,
+		monitor.Globalping{}.Type():       NewMonitorGlobalpingResource,
+		monitor.Group{}.Type():            NewMonitorGroupResource,
+		monitor.GrpcKeyword{}.Type():      NewMonitorGrpcKeywordResource,
+		monitor.HTTP{}.Type():             NewMonitorHTTPResource,
+		monitor.HTTPJSONQuery{}.Type():    NewMonitorHTTPJSONQueryResource,
+		monitor.HTTPKeyword{}.Type():      NewMonitorHTTPKeywordResource,
+		monitor.KafkaProducer{}.Type():    NewMonitorKafkaProducerResource,
+		monitor.MongoDB{}.Type():          NewMonitorMongoDBResource,
+		monitor.MQTT{}.Type():             NewMonitorMQTTResource,
+		monitor.MySQL{}.Type():            NewMonitorMySQLResource,
+		monitor.OracleDB{}.Type():         NewMonitorOracleDBResource,
+		monitor.Ping{}.Type():             NewMonitorPingResource,
+		monitor.Postgres{}.Type():         NewMonitorPostgresResource,
+		monitor.Push{}.Type():             NewMonitorPushResource,
+		monitor.RabbitMQ{}.Type():         NewMonitorRabbitMQResource,
+		monitor.Radius{}.Type():           NewMonitorRadiusResource,
+		monitor.RealBrowser{}.Type():      NewMonitorRealBrowserResource,
+		monitor.Redis{}.Type():            NewMonitorRedisResource,
+		monitor.SIPOptions{}.Type():       NewMonitorSIPOptionsResource,
+		monitor.SMTP{}.Type():             NewMonitorSMTPResource,
+		monitor.SNMP{}.Type():             NewMonitorSNMPResource,
+		monitor.SQLServer{}.Type():        NewMonitorSQLServerResource,
+		monitor.Steam{}.Type():            NewMonitorSteamResource,
+		monitor.SystemService{}.Type():    NewMonitorSystemServiceResource,
+		monitor.TailscalePing{}.Type():    NewMonitorTailscalePingResource,
+		monitor.TCPPort{}.Type():          NewMonitorTCPPortResource,
+		monitor.WebsocketUpgrade{}.Type(): NewMonitorWebsocketUpgradeResource,
+	}
+}
+
+// notificationResourcesByType maps the Uptime Kuma notification type
+// identifier to the constructor of the typed notification resource. Types
+// not contained in the map are handled by the generic notification resource.
+func notificationResourcesByType() map[string]func() resource.Resource {
+	return map[string]func() resource.Resource{
+		notification.FortySixElks{}.Type():          NewNotification46ElksResource,
+		notification.Alerta{}.Type():                NewNotificationAlertaResource,
+		notification.AlertNow{}.Type():              NewNotificationAlertNowResource,
+		notification.AliyunSMS{}.Type():             NewNotificationAliyunsmsResource,
+		notification.Apprise{}.Type():               NewNotificationAppriseResource,
+		notification.Bale{}.Type():                  NewNotificationBaleResource,
+		notification.Bark{}.Type():                  NewNotificationBarkResource,
+		notification.Bitrix24{}.Type():              NewNotificationBitrix24Resource,
+		notification.Brevo{}.Type():                 NewNotificationBrevoResource,
+		notification.CallMeBot{}.Type():             NewNotificationCallMeBotResource,
+		notification.Cellsynt{}.Type():              NewNotificationCellsyntResource,
+		notification.ClickSendSMS{}.Type():          NewNotificationClicksendSmsResource,
+		notification.DingDing{}.Type():              NewNotificationDingDingResource,
+		notification.Discord{}.Type():               NewNotificationDiscordResource,
+		notification.Evolution{}.Type():             NewNotificationEvolutionResource,
+		notification.Feishu{}.Type():                NewNotificationFeishuResource,
+		notification.FlashDuty{}.Type():             NewNotificationFlashDutyResource,
+		notification.Fluxer{}.Type():                NewNotificationFluxerResource,
+		notification.FreeMobile{}.Type():            NewNotificationFreemobileResource,
+		notification.GoAlert{}.Type():               NewNotificationGoAlertResource,
+		notification.GoogleChat{}.Type():            NewNotificationGoogleChatResource,
+		notification.GoogleSheets{}.Type():          NewNotificationGoogleSheetsResource,
+		notification.Gorush{}.Type():                NewNotificationGorushResource,
+		notification.Gotify{}.Type():                NewNotificationGotifyResource,
+		notification.GrafanaOncall{}.Type():         NewNotificationGrafanaOncallResource,
+		notification.GTXMessaging{}.Type():          NewNotificationGTXMessagingResource,
+		notification.HaloPSA{}.Type():               NewNotificationHaloPSAResource,
+		notification.HeiiOnCall{}.Type():            NewNotificationHeiiOnCallResource,
+		notification.HomeAssistant{}.Type():         NewNotificationHomeAssistantResource,
+		notification.JiraServiceManagement{}.Type(): NewNotificationJiraServiceManagementResource,
+		notification.Keep{}.Type():                  NewNotificationKeepResource,
+		notification.Kook{}.Type():                  NewNotificationKookResource,
+		notification.Line{}.Type():                  NewNotificationLineResource,
+		notification.LunaSea{}.Type():               NewNotificationLunaseaResource,
+		notification.Matrix{}.Type():                NewNotificationMatrixResource,
+		notification.Mattermost{}.Type():            NewNotificationMattermostResource,
+		notification.Max{}.Type():                   NewNotificationMaxResource,
+		notification.NextcloudTalk{}.Type():         NewNotificationNextcloudTalkResource,
+		notification.Nostr{}.Type():                 NewNotificationNostrResource,
+		notification.Notifery{}.Type():              NewNotificationNotiferyResource,
+		notification.Ntfy{}.Type():                  NewNotificationNtfyResource,
+		notification.Octopush{}.Type():              NewNotificationOctopushResource,
+		notification.OneBot{}.Type():                NewNotificationOneBotResource,
+		notification.OneChat{}.Type():               NewNotificationOneChatResource,
+		notification.OneSender{}.Type():             NewNotificationOnesenderResource,
+		notification.Opsgenie{}.Type():              NewNotificationOpsgenieResource,
+		notification.PagerDuty{}.Type():             NewNotificationPagerDutyResource,
+		notification.PagerTree{}.Type():             NewNotificationPagerTreeResource,
+		notification.PromoSMS{}.Type():              NewNotificationPromoSMSResource,
+		notification.Pumble{}.Type():                NewNotificationPumbleResource,
+		notification.Pushbullet{}.Type():            NewNotificationPushbulletResource,
+		notification.PushDeer{}.Type():              NewNotificationPushDeerResource,
+		notification.Pushover{}.Type():              NewNotificationPushoverResource,
+		notification.PushPlus{}.Type():              NewNotificationPushPlusResource,
+		notification.Pushy{}.Type():                 NewNotificationPushyResource,
+		notification.Resend{}.Type():                NewNotificationResendResource,
+		notification.RocketChat{}.Type():            NewNotificationRocketChatResource,
+		notification.SendGrid{}.Type():              NewNotificationSendgridResource,
+		notification.ServerChan{}.Type():            NewNotificationServerChanResource,
+		notification.SerwerSMS{}.Type():             NewNotificationSerwersmsResource,
+		notification.SevenIO{}.Type():               NewNotificationSevenioResource,
+		notification.Signal{}.Type():                NewNotificationSignalResource,
+		notification.SIGNL4{}.Type():                NewNotificationSIGNL4Resource,
+		notification.Slack{}.Type():                 NewNotificationSlackResource,
+		notification.SMSC{}.Type():                  NewNotificationSMSCResource,
+		notification.SMSEagle{}.Type():              NewNotificationSMSEagleResource,
+		notification.SMSIR{}.Type():                 NewNotificationSMSIRResource,
+		notification.SMSManager{}.Type():            NewNotificationSMSManagerResource,
+		notification.SMSPartner{}.Type():            NewNotificationSMSPartnerResource,
+		notification.SMSPlanet{}.Type():             NewNotificationSMSPlanetResource,
+		notification.SMTP{}.Type():                  NewNotificationSMTPResource,
+		notification.Splunk{}.Type():                NewNotificationSplunkResource,
+		notification.SpugPush{}.Type():              NewNotificationSpugPushResource,
+		notification.Squadcast{}.Type():             NewNotificationSquadcastResource,
+		notification.Stackfield{}.Type():            NewNotificationStackfieldResource,
+		notification.Teams{}.Type():                 NewNotificationTeamsResource,
+		notification.TechulusPush{}.Type():          NewNotificationTechulusPushResource,
+		notification.Telegram{}.Type():              NewNotificationTelegramResource,
+		notification.Telnyx{}.Type():                NewNotificationTelnyxResource,
+		notification.Teltonika{}.Type():             NewNotificationTeltonikaResource,
+		notification.Threema{}.Type():               NewNotificationThreemaResource,
+		notification.Twilio{}.Type():                NewNotificationTwilioResource,
+		notification.VK{}.Type():                    NewNotificationVKResource,
+		notification.WAHA{}.Type():                  NewNotificationWAHAResource,
+		notification.Webhook{}.Type():               NewNotificationWebhookResource,
+		notification.Webpush{}.Type():               NewNotificationWebpushResource,
+		notification.WeCom{}.Type():                 NewNotificationWeComResource,
+		notification.Whapi{}.Type():                 NewNotificationWhapiResource,
+		notification.Whatsapp360messenger{}.Type():  NewNotificationWhatsapp360messengerResource,
+		notification.WPush{}.Type():                 NewNotificationWPushResource,
+		notification.YZJ{}.Type():                   NewNotificationYZJResource,
+		notification.ZohoCliq{}.Type():              NewNotificationZohoCliqResource,
+	}
+}
+
+// notificationModelsByType maps the Uptime Kuma notification type identifier
+// to a new, empty instance of the corresponding notification type.
+func notificationModelsByType() map[string]notification.Notification {
+	return map[string]notification.Notification{
+		notification.FortySixElks{}.Type():          &notification.FortySixElks{},
+		notification.Alerta{}.Type():                &notification.Alerta{},
+		notification.AlertNow{}.Type():              &notification.AlertNow{},
+		notification.AliyunSMS{}.Type():             &notification.AliyunSMS{},
+		notification.Apprise{}.Type():               &notification.Apprise{},
+		notification.Bale{}.Type():                  &notification.Bale{},
+		notification.Bark{}.Type():                  &notification.Bark{},
+		notification.Bitrix24{}.Type():              &notification.Bitrix24{},
+		notification.Brevo{}.Type():                 &notification.Brevo{},
+		notification.CallMeBot{}.Type():             &notification.CallMeBot{},
+		notification.Cellsynt{}.Type():              &notification.Cellsynt{},
+		notification.ClickSendSMS{}.Type():          &notification.ClickSendSMS{},
+		notification.DingDing{}.Type():              &notification.DingDing{},
+		notification.Discord{}.Type():               &notification.Discord{},
+		notification.Evolution{}.Type():             &notification.Evolution{},
+		notification.Feishu{}.Type():                &notification.Feishu{},
+		notification.FlashDuty{}.Type():             &notification.FlashDuty{},
+		notification.Fluxer{}.Type():                &notification.Fluxer{},
+		notification.FreeMobile{}.Type():            &notification.FreeMobile{},
+		notification.GoAlert{}.Type():               &notification.GoAlert{},
+		notification.GoogleChat{}.Type():            &notification.GoogleChat{},
+		notification.GoogleSheets{}.Type():          &notification.GoogleSheets{},
+		notification.Gorush{}.Type():                &notification.Gorush{},
+		notification.Gotify{}.Type():                &notification.Gotify{},
+		notification.GrafanaOncall{}.Type():         &notification.GrafanaOncall{},
+		notification.GTXMessaging{}.Type():          &notification.GTXMessaging{},
+		notification.HaloPSA{}.Type():               &notification.HaloPSA{},
+		notification.HeiiOnCall{}.Type():            &notification.HeiiOnCall{},
+		notification.HomeAssistant{}.Type():         &notification.HomeAssistant{},
+		notification.JiraServiceManagement{}.Type(): &notification.JiraServiceManagement{},
+		notification.Keep{}.Type():                  &notification.Keep{},
+		notification.Kook{}.Type():                  &notification.Kook{},
+		notification.Line{}.Type():                  &notification.Line{},
+		notification.LunaSea{}.Type():               &notification.LunaSea{},
+		notification.Matrix{}.Type():                &notification.Matrix{},
+		notification.Mattermost{}.Type():            &notification.Mattermost{},
+		notification.Max{}.Type():                   &notification.Max{},
+		notification.NextcloudTalk{}.Type():         &notification.NextcloudTalk{},
+		notification.Nostr{}.Type():                 &notification.Nostr{},
+		notification.Notifery{}.Type():              &notification.Notifery{},
+		notification.Ntfy{}.Type():                  &notification.Ntfy{},
+		notification.Octopush{}.Type():              &notification.Octopush{},
+		notification.OneBot{}.Type():                &notification.OneBot{},
+		notification.OneChat{}.Type():               &notification.OneChat{},
+		notification.OneSender{}.Type():             &notification.OneSender{},
+		notification.Opsgenie{}.Type():              &notification.Opsgenie{},
+		notification.PagerDuty{}.Type():             &notification.PagerDuty{},
+		notification.PagerTree{}.Type():             &notification.PagerTree{},
+		notification.PromoSMS{}.Type():              &notification.PromoSMS{},
+		notification.Pumble{}.Type():                &notification.Pumble{},
+		notification.Pushbullet{}.Type():            &notification.Pushbullet{},
+		notification.PushDeer{}.Type():              &notification.PushDeer{},
+		notification.Pushover{}.Type():              &notification.Pushover{},
+		notification.PushPlus{}.Type():              &notification.PushPlus{},
+		notification.Pushy{}.Type():                 &notification.Pushy{},
+		notification.Resend{}.Type():                &notification.Resend{},
+		notification.RocketChat{}.Type():            &notification.RocketChat{},
+		notification.SendGrid{}.Type():              &notification.SendGrid{},
+		notification.ServerChan{}.Type():            &notification.ServerChan{},
+		notification.SerwerSMS{}.Type():             &notification.SerwerSMS{},
+		notification.SevenIO{}.Type():               &notification.SevenIO{},
+		notification.Signal{}.Type():                &notification.Signal{},
+		notification.SIGNL4{}.Type():                &notification.SIGNL4{},
+		notification.Slack{}.Type():                 &notification.Slack{},
+		notification.SMSC{}.Type():                  &notification.SMSC{},
+		notification.SMSEagle{}.Type():              &notification.SMSEagle{},
+		notification.SMSIR{}.Type():                 &notification.SMSIR{},
+		notification.SMSManager{}.Type():            &notification.SMSManager{},
+		notification.SMSPartner{}.Type():            &notification.SMSPartner{},
+		notification.SMSPlanet{}.Type():             &notification.SMSPlanet{},
+		notification.SMTP{}.Type():                  &notification.SMTP{},
+		notification.Splunk{}.Type():                &notification.Splunk{},
+		notification.SpugPush{}.Type():              &notification.SpugPush{},
+		notification.Squadcast{}.Type():             &notification.Squadcast{},
+		notification.Stackfield{}.Type():            &notification.Stackfield{},
+		notification.Teams{}.Type():                 &notification.Teams{},
+		notification.TechulusPush{}.Type():          &notification.TechulusPush{},
+		notification.Telegram{}.Type():              &notification.Telegram{},
+		notification.Telnyx{}.Type():                &notification.Telnyx{},
+		notification.Teltonika{}.Type():             &notification.Teltonika{},
+		notification.Threema{}.Type():               &notification.Threema{},
+		notification.Twilio{}.Type():                &notification.Twilio{},
+		notification.VK{}.Type():                    &notification.VK{},
+		notification.WAHA{}.Type():                  &notification.WAHA{},
+		notification.Webhook{}.Type():               &notification.Webhook{},
+		notification.Webpush{}.Type():               &notification.Webpush{},
+		notification.WeCom{}.Type():                 &notification.WeCom{},
+		notification.Whapi{}.Type():                 &notification.Whapi{},
+		notification.Whatsapp360messenger{}.Type():  &notification.Whatsapp360messenger{},
+		notification.WPush{}.Type():                 &notification.WPush{},
+		notification.YZJ{}.Type():                   &notification.YZJ{},
+		notification.ZohoCliq{}.Type():              &notification.ZohoCliq{},
+	}
+}
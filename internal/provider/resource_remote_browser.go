@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &RemoteBrowserResource{}
+	_ resource.ResourceWithImportState = &RemoteBrowserResource{}
+)
+
+// NewRemoteBrowserResource returns a new instance of the remote browser resource.
+func NewRemoteBrowserResource() resource.Resource {
+	return &RemoteBrowserResource{}
+}
+
+// RemoteBrowserResource defines the resource implementation.
+type RemoteBrowserResource struct {
+	providerData *providerData
+}
+
+// RemoteBrowserResourceModel describes the resource data model.
+type RemoteBrowserResourceModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	URL  types.String `tfsdk:"url"`
+}
+
+// remoteBrowser is a remote browser as exchanged with Uptime Kuma. Remote
+// browsers are not supported by the Uptime Kuma client library.
+type remoteBrowser struct {
+	ID   int64  `json:"id,omitempty"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Metadata returns the metadata for the resource.
+func (*RemoteBrowserResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_remote_browser"
+}
+
+// Schema returns the schema for the resource.
+func (*RemoteBrowserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Remote browser resource for managing the Playwright compatible browsers used by " +
+			"Real Browser monitors in Uptime Kuma",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Remote browser identifier",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable name for the remote browser",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				MarkdownDescription: "WebSocket URL of the remote browser " +
+					"(e.g., ws://browserless:3000/chromium/playwright?token=secret)",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^wss?://`),
+						"must be a WebSocket URL starting with ws:// or wss://",
+					),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the API client.
+func (r *RemoteBrowserResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	r.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates a new resource.
+func (r *RemoteBrowserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RemoteBrowserResourceModel
+
+	// Extract planned configuration.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call API to create remote browser.
+	id, err := saveRemoteBrowser(ctx, r.providerData, nil, remoteBrowser{
+		Name: data.Name.ValueString(),
+		URL:  data.URL.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create remote browser", err.Error())
+		return
+	}
+
+	// Set computed ID and save state.
+	data.ID = types.Int64Value(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the current state of the resource.
+func (r *RemoteBrowserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RemoteBrowserResourceModel
+
+	// Get resource from state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch current remote browser configuration from API.
+	rb, err := getRemoteBrowser(ctx, r.providerData, data.ID.ValueInt64())
+	if err != nil {
+		// Handle resource not found error.
+		if errors.Is(err, errRemoteBrowserNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to read remote browser", err.Error())
+		return
+	}
+
+	// Update resource attributes from API response.
+	data.Name = types.StringValue(rb.Name)
+	data.URL = types.StringValue(rb.URL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource.
+func (r *RemoteBrowserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RemoteBrowserResourceModel
+
+	// Extract planned configuration.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call API to update remote browser.
+	id := data.ID.ValueInt64()
+
+	_, err := saveRemoteBrowser(ctx, r.providerData, &id, remoteBrowser{
+		ID:   id,
+		Name: data.Name.ValueString(),
+		URL:  data.URL.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update remote browser", err.Error())
+		return
+	}
+
+	// Save updated state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource.
+func (r *RemoteBrowserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RemoteBrowserResourceModel
+
+	// Get resource from state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Call API to delete remote browser.
+	err := deleteRemoteBrowser(ctx, r.providerData, data.ID.ValueInt64())
+	if err != nil {
+		if errors.Is(err, errRemoteBrowserNotFound) {
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to delete remote browser", err.Error())
+		return
+	}
+}
+
+// ImportState imports an existing resource by ID.
+func (*RemoteBrowserResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be a valid integer, got: %s", req.ID),
+		)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// saveRemoteBrowser creates a remote browser or, if id is not nil, updates
+// the remote browser with this ID. It returns the ID of the remote browser.
+func saveRemoteBrowser(ctx context.Context, pd *providerData, id *int64, rb remoteBrowser) (int64, error) {
+	var res struct {
+		ID int64 `json:"id"`
+	}
+
+	err := pd.callRemoteBrowserEvent(ctx, "addRemoteBrowser", &res, rb, id)
+	// Handle error.
+	if err != nil {
+		if id != nil {
+			return 0, pd.remoteBrowserError(ctx, *id, err)
+		}
+
+		return 0, err
+	}
+
+	return res.ID, nil
+}
+
+// getRemoteBrowser returns the remote browser with the given ID.
+func getRemoteBrowser(ctx context.Context, pd *providerData, id int64) (remoteBrowser, error) {
+	var res struct {
+		RemoteBrowser *remoteBrowser `json:"remoteBrowser"`
+	}
+
+	err := pd.callRemoteBrowserEvent(ctx, "getRemoteBrowser", &res, id)
+	// Handle error.
+	if err != nil {
+		return remoteBrowser{}, pd.remoteBrowserError(ctx, id, err)
+	}
+
+	if res.RemoteBrowser == nil {
+		return remoteBrowser{}, fmt.Errorf("remote browser %d: %w", id, errRemoteBrowserNotFound)
+	}
+
+	return *res.RemoteBrowser, nil
+}
+
+// getRemoteBrowsers returns all remote browsers. Uptime Kuma does not provide
+// an event to query the list, but sends it after the login and after every
+// change.
+func getRemoteBrowsers(ctx context.Context, pd *providerData) ([]remoteBrowser, error) {
+	_, received, err := pd.openRemoteBrowserSession(ctx)
+	// Handle error.
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-received:
+	case <-time.After(remoteBrowserListTimeout):
+		return nil, errors.New("timeout waiting for the list of remote browsers")
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	pd.remoteBrowsers.listMu.Lock()
+	defer pd.remoteBrowsers.listMu.Unlock()
+
+	return slices.Clone(pd.remoteBrowsers.list), nil
+}
+
+// deleteRemoteBrowser deletes the remote browser with the given ID.
+func deleteRemoteBrowser(ctx context.Context, pd *providerData, id int64) error {
+	err := pd.callRemoteBrowserEvent(ctx, "deleteRemoteBrowser", nil, id)
+	// Handle error.
+	if err != nil {
+		return pd.remoteBrowserError(ctx, id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRemoteBrowserResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("TestRemoteBrowser")
+	nameUpdated := acctest.RandomWithPrefix("TestRemoteBrowserUpdated")
+	url := "ws://browserless:3000/chromium/playwright"
+	urlUpdated := "wss://browserless.example.com/chromium/playwright?token=secret"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemoteBrowserResourceConfig(name, url),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_remote_browser.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(name),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_remote_browser.test",
+						tfjsonpath.New("url"),
+						knownvalue.StringExact(url),
+					),
+				},
+			},
+			{
+				Config: testAccRemoteBrowserResourceConfig(nameUpdated, urlUpdated),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_remote_browser.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(nameUpdated),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_remote_browser.test",
+						tfjsonpath.New("url"),
+						knownvalue.StringExact(urlUpdated),
+					),
+				},
+			},
+			{
+				ResourceName:      "uptimekuma_remote_browser.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccRemoteBrowserResourceConfig(name, "http://browserless:3000"),
+				ExpectError: regexp.MustCompile(`must be a WebSocket URL`),
+			},
+		},
+	})
+}
+
+func testAccRemoteBrowserResourceConfig(name string, url string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_remote_browser" "test" {
+  name = %[1]q
+  url  = %[2]q
+}
+`, name, url)
+}
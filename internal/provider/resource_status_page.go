@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,11 +19,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	kuma "github.com/breml/go-uptime-kuma-client"
 	"github.com/breml/go-uptime-kuma-client/statuspage"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
 )
 
-var _ resource.Resource = &StatusPageResource{}
+var (
+	_ resource.Resource                = &StatusPageResource{}
+	_ resource.ResourceWithImportState = &StatusPageResource{}
+	_ resource.ResourceWithModifyPlan  = &StatusPageResource{}
+)
 
 // statusPageIconValidator validates the icon field format.
 type statusPageIconValidator struct{}
@@ -89,7 +96,7 @@ func NewStatusPageResource() resource.Resource {
 
 // StatusPageResource defines the resource implementation.
 type StatusPageResource struct {
-	client *kuma.Client
+	providerData *providerData
 }
 
 // StatusPageResourceModel describes the resource data model.
@@ -99,6 +106,8 @@ type StatusPageResourceModel struct {
 	Title                 types.String `tfsdk:"title"`
 	Description           types.String `tfsdk:"description"`
 	Icon                  types.String `tfsdk:"icon"`
+	IconSource            types.String `tfsdk:"icon_source"`
+	IconSHA256            types.String `tfsdk:"icon_sha256"`
 	Theme                 types.String `tfsdk:"theme"`
 	Published             types.Bool   `tfsdk:"published"`
 	ShowTags              types.Bool   `tfsdk:"show_tags"`
@@ -111,15 +120,29 @@ type StatusPageResourceModel struct {
 	FooterText            types.String `tfsdk:"footer_text"`
 	ShowPoweredBy         types.Bool   `tfsdk:"show_powered_by"`
 	ShowCertificateExpiry types.Bool   `tfsdk:"show_certificate_expiry"`
+	AutoRefreshInterval   types.Int64  `tfsdk:"auto_refresh_interval"`
 	PublicGroupList       types.List   `tfsdk:"public_group_list"`
+	ExclusiveGroups       types.Bool   `tfsdk:"exclusive_groups"`
 }
 
 // PublicGroupModel describes a public group in a status page.
 type PublicGroupModel struct {
-	ID          types.Int64  `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Weight      types.Int64  `tfsdk:"weight"`
-	MonitorList types.List   `tfsdk:"monitor_list"`
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Weight             types.Int64  `tfsdk:"weight"`
+	MonitorList        types.List   `tfsdk:"monitor_list"`
+	MonitorSelector    types.Object `tfsdk:"monitor_selector"`
+	SelectedMonitorIDs types.List   `tfsdk:"selected_monitor_ids"`
+}
+
+// MonitorSelectorModel describes the selection of the monitors of a public
+// group by their tags, type or parent group.
+type MonitorSelectorModel struct {
+	TagID    types.Int64  `tfsdk:"tag_id"`
+	TagName  types.String `tfsdk:"tag_name"`
+	TagValue types.String `tfsdk:"tag_value"`
+	Type     types.String `tfsdk:"type"`
+	Parent   types.Int64  `tfsdk:"parent"`
 }
 
 // PublicMonitorModel describes a monitor in a public group.
@@ -171,12 +194,29 @@ func (*StatusPageResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				MarkdownDescription: "Icon for the status page. Accepts a PNG data URI (`data:image/png;base64,...`)" +
 					" or a URL/path (max 255 characters). When a data URI is provided," +
 					" Uptime Kuma converts it to a file on disk.",
-				Optional:   true,
-				Validators: []validator.String{validateStatusPageIcon()},
+				Optional: true,
+				Validators: []validator.String{
+					validateStatusPageIcon(),
+					stringvalidator.ConflictsWith(path.MatchRoot("icon_source")),
+				},
+			},
+			"icon_source": schema.StringAttribute{
+				MarkdownDescription: "Icon for the status page as file path or base64 encoded content (e.g. " +
+					"`filebase64(\"logo.png\")`). PNG, JPEG and GIF images are supported, JPEG and GIF images " +
+					"are converted to PNG. The icon is only uploaded, if `icon_sha256` changes",
+				Optional: true,
+			},
+			"icon_sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the icon of `icon_source` in PNG format. Changes of the " +
+					"icon on the server show up as a change of the hash",
+				Computed: true,
 			},
 			"theme": schema.StringAttribute{
-				MarkdownDescription: "Theme name for styling",
+				MarkdownDescription: "Theme name for styling. One of `auto`, `light` or `dark`",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "light", "dark"),
+				},
 			},
 			"published": schema.BoolAttribute{
 				MarkdownDescription: "Whether page is publicly visible",
@@ -191,9 +231,14 @@ func (*StatusPageResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Default:             booldefault.StaticBool(false),
 			},
 			"domain_name_list": schema.ListAttribute{
-				MarkdownDescription: "Custom domain names",
-				ElementType:         types.StringType,
-				Optional:            true,
+				MarkdownDescription: "Custom domain names. The domain names must be lowercase hostnames without " +
+					"scheme, port or path, internationalized domain names in punycode. A domain name can only be " +
+					"used by one status page",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(statusPageDomainValidator{}),
+				},
 			},
 			"google_analytics_id": schema.StringAttribute{
 				MarkdownDescription: "Google Analytics tracking ID",
@@ -205,10 +250,16 @@ func (*StatusPageResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"analytics_type": schema.StringAttribute{
-				MarkdownDescription: "Analytics provider type (e.g. google, matomo, plausible, umami)",
+				MarkdownDescription: "Analytics provider type. One of `google`, `matomo`, `plausible` or `umami`",
 				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(path.MatchRoot("google_analytics_id")),
+					stringvalidator.OneOf(
+						statuspage.AnalyticsTypeGoogle(),
+						statuspage.AnalyticsTypeMatomo(),
+						statuspage.AnalyticsTypePlausible(),
+						statuspage.AnalyticsTypeUmami(),
+					),
 				},
 			},
 			"analytics_id": schema.StringAttribute{
@@ -239,6 +290,19 @@ func (*StatusPageResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"auto_refresh_interval": schema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds, in which the status page refreshes itself. `0` disables " +
+					"the auto refresh. Only supported by Uptime Kuma 2.x. If not set, the current interval of the " +
+					"status page is kept",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"public_group_list": schema.ListNestedAttribute{
 				MarkdownDescription: "Monitor grouping configuration",
 				Optional:            true,
@@ -282,9 +346,61 @@ func (*StatusPageResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 								},
 							},
 						},
+						"monitor_selector": schema.SingleNestedAttribute{
+							MarkdownDescription: "Select the monitors of the group by tag, type and parent group, " +
+								"in addition to `monitor_list`. All criteria must match. The matching monitors are " +
+								"resolved at plan time, so monitors matched later show up as a change of " +
+								"`selected_monitor_ids` in the next plan",
+							Optional: true,
+							Validators: []validator.Object{
+								monitorSelectorValidator{},
+							},
+							Attributes: map[string]schema.Attribute{
+								"tag_id": schema.Int64Attribute{
+									MarkdownDescription: "ID of the tag of the monitors",
+									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.ConflictsWith(
+											path.MatchRelative().AtParent().AtName("tag_name"),
+										),
+									},
+								},
+								"tag_name": schema.StringAttribute{
+									MarkdownDescription: "Name of the tag of the monitors",
+									Optional:            true,
+								},
+								"tag_value": schema.StringAttribute{
+									MarkdownDescription: "Value of the tag. If not set, the monitors match " +
+										"regardless of the tag value",
+									Optional: true,
+								},
+								"type": schema.StringAttribute{
+									MarkdownDescription: "Monitor type (e.g. http, ping or dns)",
+									Optional:            true,
+								},
+								"parent": schema.Int64Attribute{
+									MarkdownDescription: "ID of the parent group monitor",
+									Optional:            true,
+								},
+							},
+						},
+						"selected_monitor_ids": schema.ListAttribute{
+							MarkdownDescription: "IDs of the monitors matched by `monitor_selector`, which are " +
+								"not listed in `monitor_list`",
+							ElementType: types.Int64Type,
+							Computed:    true,
+						},
 					},
 				},
 			},
+			"exclusive_groups": schema.BoolAttribute{
+				MarkdownDescription: "Whether `public_group_list` holds all groups of the status page. If false, " +
+					"groups not listed in `public_group_list`, e.g. managed by `uptimekuma_status_page_group`, " +
+					"are kept",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
 		},
 	}
 }
@@ -295,7 +411,58 @@ func (r *StatusPageResource) Configure(
 	req resource.ConfigureRequest,
 	resp *resource.ConfigureResponse,
 ) {
-	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan resolves the monitor selectors of the public groups, so new
+// matching monitors show up as a change of `selected_monitor_ids`. The
+// selected monitors are only planned as known values, if nothing else changes.
+// Otherwise they are resolved at apply, as monitors created in the same apply
+// would result in an inconsistent plan.
+func (r *StatusPageResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to plan on destroy or before the provider is configured.
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planIconSHA256(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkStatusPageDomains(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var groupList types.List
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("public_group_list"), &groupList)...)
+
+	if resp.Diagnostics.HasError() || groupList.IsNull() || groupList.IsUnknown() {
+		return
+	}
+
+	if req.State.Raw.IsNull() || !req.Plan.Raw.Equal(req.State.Raw) {
+		groupList = markSelectedMonitorsUnknown(ctx, groupList, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_group_list"), groupList)...)
+
+		return
+	}
+
+	resolver := &monitorSelectorResolver{providerData: r.providerData}
+
+	resolved := resolver.resolveGroupList(ctx, groupList, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || resolved.Equal(groupList) {
+		return
+	}
+
+	groupList = markSelectedMonitorsUnknown(ctx, groupList, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_group_list"), groupList)...)
 }
 
 // Create creates a new status page resource.
@@ -310,12 +477,18 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// First, create the base status page. Some fields like domain names and groups
 	// are managed separately via SaveStatusPage, so we start with title and slug.
-	err := r.client.AddStatusPage(ctx, data.Title.ValueString(), data.Slug.ValueString())
+	err := r.providerData.client.AddStatusPage(ctx, data.Title.ValueString(), data.Slug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create status page", err.Error())
 		return
 	}
 
+	// Resolve the monitor selectors, which were not known at plan time.
+	r.resolveMonitorSelectors(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build the status page object with all configuration, using the same
 	// conversion logic as Update so Create and Update never diverge.
 	sp := buildStatusPageFromModel(ctx, &data, &resp.Diagnostics)
@@ -323,15 +496,20 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	r.applyIconSource(ctx, &data, types.StringNull(), sp, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save the complete status page configuration and get back group IDs.
-	savedGroups, err := r.client.SaveStatusPage(ctx, sp)
+	savedGroups, err := r.saveStatusPage(ctx, &data, sp, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to save status page", err.Error())
 		return
 	}
 
 	// Retrieve the saved status page to get the assigned ID.
-	retrievedSP, err := r.client.GetStatusPage(ctx, data.Slug.ValueString())
+	retrievedSP, err := r.providerData.client.GetStatusPage(ctx, data.Slug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("failed to read status page after creation", err.Error())
 		return
@@ -344,6 +522,11 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	r.readAutoRefreshInterval(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -357,7 +540,7 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	sp, err := r.client.GetStatusPage(ctx, data.Slug.ValueString())
+	sp, err := r.providerData.client.GetStatusPage(ctx, data.Slug.ValueString())
 	if err != nil {
 		if isNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
@@ -376,6 +559,11 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Icon = stringOrNullPreserveEmpty(sp.Icon, data.Icon)
 	}
 
+	data.IconSHA256 = r.readIconSHA256(ctx, &data, sp, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.Theme = stringOrNullPreserveEmpty(sp.Theme, data.Theme)
 
 	// Note: The Uptime Kuma API's saveStatusPage endpoint does not actually update
@@ -383,6 +571,8 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 	// (see server/socket-handlers/status-page-socket-handler.js line 160-167).
 	// Therefore, we don't update these fields from the API response to avoid drift.
 	// We keep whatever values are in the Terraform config/state.
+	// The only exception is import, where there is no prior state to keep.
+	importStatusPageFlags(&data, sp)
 
 	// When the deprecated google_analytics_id is in use, only update that field
 	// and leave the new analytics fields as null to avoid perpetual diffs.
@@ -397,6 +587,11 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.CustomCSS = stringOrNullPreserveEmpty(sp.CustomCSS, data.CustomCSS)
 	data.FooterText = stringOrNullPreserveEmpty(sp.FooterText, data.FooterText)
 
+	r.readAutoRefreshInterval(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if len(sp.DomainNameList) > 0 {
 		domainNames, diags := types.ListValueFrom(ctx, types.StringType, sp.DomainNameList)
 		resp.Diagnostics.Append(diags...)
@@ -430,12 +625,35 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	r.resolveMonitorSelectors(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sp := buildStatusPageFromModel(ctx, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	savedGroups, err := r.client.SaveStatusPage(ctx, sp)
+	var state StatusPageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyIconSource(ctx, &data, state.IconSHA256, sp, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Groups removed from public_group_list are only known from the state.
+	owned := publicGroupIDs(ctx, &resp.Diagnostics, state.PublicGroupList, data.PublicGroupList)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	savedGroups, err := r.saveStatusPage(ctx, &data, sp, owned)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update status page", err.Error())
 		return
@@ -446,9 +664,80 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	r.readAutoRefreshInterval(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// saveStatusPage saves the status page and returns the saved groups in the
+// order of public_group_list. Unless exclusive_groups is true, the groups of
+// the status page not in owned are kept.
+func (r *StatusPageResource) saveStatusPage(
+	ctx context.Context,
+	data *StatusPageResourceModel,
+	sp *statuspage.StatusPage,
+	owned []int64,
+) ([]statuspage.PublicGroup, error) {
+	autoRefreshInterval := int64ToPtr(data.AutoRefreshInterval)
+
+	if data.ExclusiveGroups.ValueBool() {
+		return r.providerData.saveStatusPage(ctx, sp, autoRefreshInterval)
+	}
+
+	return r.providerData.saveStatusPageGroups(ctx, sp, autoRefreshInterval, owned)
+}
+
+// readAutoRefreshInterval populates auto_refresh_interval from the public
+// status page API. Uptime Kuma 1.x does not know the setting, in which case
+// the value is kept, or null if it is unknown.
+func (r *StatusPageResource) readAutoRefreshInterval(
+	ctx context.Context,
+	data *StatusPageResourceModel,
+	diags *diag.Diagnostics,
+) {
+	if r.providerData.clientConfig == nil {
+		if data.AutoRefreshInterval.IsUnknown() {
+			data.AutoRefreshInterval = types.Int64Null()
+		}
+
+		return
+	}
+
+	pageData, err := client.GetStatusPageData(ctx, r.providerData.clientConfig, data.Slug.ValueString())
+	// Handle error.
+	if err != nil {
+		diags.AddError("failed to read status page", err.Error())
+		return
+	}
+
+	if pageData.Config.AutoRefreshInterval != nil {
+		data.AutoRefreshInterval = types.Int64Value(*pageData.Config.AutoRefreshInterval)
+		return
+	}
+
+	if data.AutoRefreshInterval.IsUnknown() {
+		data.AutoRefreshInterval = types.Int64Null()
+	}
+}
+
+// resolveMonitorSelectors resolves the monitor selectors of the public groups,
+// which could not be resolved at plan time.
+func (r *StatusPageResource) resolveMonitorSelectors(
+	ctx context.Context,
+	data *StatusPageResourceModel,
+	diags *diag.Diagnostics,
+) {
+	if data.PublicGroupList.IsNull() {
+		return
+	}
+
+	resolver := &monitorSelectorResolver{providerData: r.providerData}
+	data.PublicGroupList = resolver.resolveGroupList(ctx, data.PublicGroupList, true, diags)
+}
+
 func buildStatusPageFromModel(
 	ctx context.Context,
 	data *StatusPageResourceModel,
@@ -525,6 +814,16 @@ func convertGroupModelsToAPI(
 			publicGroup.MonitorList = convertMonitorModelsToAPI(monitors)
 		}
 
+		if !group.SelectedMonitorIDs.IsNull() && !group.SelectedMonitorIDs.IsUnknown() {
+			var selected []int64
+			diags.Append(group.SelectedMonitorIDs.ElementsAs(ctx, &selected, false)...)
+			if diags.HasError() {
+				return publicGroups
+			}
+
+			publicGroup.MonitorList = appendSelectedMonitors(publicGroup.MonitorList, selected)
+		}
+
 		publicGroups[i] = publicGroup
 	}
 
@@ -575,13 +874,37 @@ func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := r.client.DeleteStatusPage(ctx, data.Slug.ValueString())
+	err := r.providerData.client.DeleteStatusPage(ctx, data.Slug.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("failed to delete status page", err.Error())
 		return
 	}
 }
 
+// importStatusPageFlags fills the boolean flags from the server response when
+// they are not yet known, which is only the case right after an import.
+func importStatusPageFlags(data *StatusPageResourceModel, sp *statuspage.StatusPage) {
+	if data.Published.IsNull() {
+		data.Published = types.BoolValue(sp.Published)
+	}
+
+	if data.ShowTags.IsNull() {
+		data.ShowTags = types.BoolValue(sp.ShowTags)
+	}
+
+	if data.ShowPoweredBy.IsNull() {
+		data.ShowPoweredBy = types.BoolValue(sp.ShowPoweredBy)
+	}
+
+	if data.ShowCertificateExpiry.IsNull() {
+		data.ShowCertificateExpiry = types.BoolValue(sp.ShowCertificateExpiry)
+	}
+
+	if data.ExclusiveGroups.IsNull() {
+		data.ExclusiveGroups = types.BoolValue(true)
+	}
+}
+
 // resolveAnalyticsFields returns the analytics type and ID from the model,
 // mapping the deprecated google_analytics_id to the new fields if set.
 func resolveAnalyticsFields(data *StatusPageResourceModel) (analyticsType *string, analyticsID string) {
@@ -592,3 +915,21 @@ func resolveAnalyticsFields(data *StatusPageResourceModel) (analyticsType *strin
 
 	return strToPtr(data.AnalyticsType), data.AnalyticsID.ValueString()
 }
+
+// ImportState imports an existing resource by slug.
+func (*StatusPageResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be the slug of the status page",
+		)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("slug"), req.ID)...)
+}
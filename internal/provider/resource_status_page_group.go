@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
+)
+
+var (
+	_ resource.Resource                = &StatusPageGroupResource{}
+	_ resource.ResourceWithImportState = &StatusPageGroupResource{}
+)
+
+// NewStatusPageGroupResource returns a new instance of the status page group resource.
+func NewStatusPageGroupResource() resource.Resource {
+	return &StatusPageGroupResource{}
+}
+
+// StatusPageGroupResource defines the resource implementation.
+type StatusPageGroupResource struct {
+	providerData *providerData
+}
+
+// StatusPageGroupResourceModel describes the resource data model.
+type StatusPageGroupResourceModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	StatusPageSlug types.String `tfsdk:"status_page_slug"`
+	Name           types.String `tfsdk:"name"`
+	Weight         types.Int64  `tfsdk:"weight"`
+	MonitorList    types.List   `tfsdk:"monitor_list"`
+}
+
+// Metadata returns the metadata for the resource.
+func (*StatusPageGroupResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_group"
+}
+
+// Schema returns the schema for the resource.
+func (*StatusPageGroupResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Public group of a status page. The other groups of the status page are kept, " +
+			"so several configurations can manage their own groups of a shared status page. A status page " +
+			"managed by `uptimekuma_status_page` must set `exclusive_groups` to false",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Public group ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_slug": schema.StringAttribute{
+				MarkdownDescription: "Slug of the status page",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Group display name",
+				Required:            true,
+			},
+			"weight": schema.Int64Attribute{
+				MarkdownDescription: "Display order/weight. The groups are ordered by weight and Uptime Kuma " +
+					"renumbers the weights of all groups of the status page starting at 1 on every save. " +
+					"If not set, the group keeps its position or is added at the end",
+				Optional: true,
+			},
+			"monitor_list": schema.ListNestedAttribute{
+				MarkdownDescription: "Monitors in group",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Monitor ID",
+							Required:            true,
+						},
+						"send_url": schema.BoolAttribute{
+							MarkdownDescription: "Include monitor URL in status page",
+							Optional:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Custom URL to use as the clickable link for this" +
+								" monitor on the status page, overriding the monitor's own check URL." +
+								" Only takes effect when `send_url` is also set to `true`",
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource with the API client.
+func (r *StatusPageGroupResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	r.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates a new resource.
+func (r *StatusPageGroupResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var data StatusPageGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.saveGroup(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reads the current state of the resource.
+func (r *StatusPageGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StatusPageGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.providerData.clientConfig == nil {
+		resp.Diagnostics.AddError(
+			"failed to read status page group",
+			"provider connection settings are not available",
+		)
+		return
+	}
+
+	pageData, err := client.GetStatusPageData(ctx, r.providerData.clientConfig, data.StatusPageSlug.ValueString())
+	if err != nil {
+		// Handle resource not found error.
+		if errors.Is(err, client.ErrStatusPageNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to read status page group", err.Error())
+		return
+	}
+
+	for _, group := range pageData.PublicGroupList {
+		if group.ID != data.ID.ValueInt64() {
+			continue
+		}
+
+		data.Name = types.StringValue(group.Name)
+		data.MonitorList = publicMonitorListFromAPI(ctx, group.MonitorList, data.MonitorList, &resp.Diagnostics)
+
+		// Populate state.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Update updates the resource.
+func (r *StatusPageGroupResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var data StatusPageGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.saveGroup(ctx, &data, []int64{data.ID.ValueInt64()}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource.
+func (r *StatusPageGroupResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var data StatusPageGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sp, err := r.providerData.client.GetStatusPage(ctx, data.StatusPageSlug.ValueString())
+	if err != nil {
+		// The group is deleted together with the status page.
+		if isNotFoundError(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to delete status page group", err.Error())
+		return
+	}
+
+	sp.PublicGroupList = []statuspage.PublicGroup{}
+
+	_, err = r.providerData.saveStatusPageGroups(ctx, sp, nil, []int64{data.ID.ValueInt64()})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete status page group", err.Error())
+		return
+	}
+}
+
+// ImportState imports an existing resource by `<status_page_slug>/<id>`.
+func (*StatusPageGroupResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	slug, id, err := parseStatusPageGroupID(req.ID)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in the format <status_page_slug>/<id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_slug"), slug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// saveGroup saves the group to its status page and populates the ID. The
+// other groups of the status page are kept.
+func (r *StatusPageGroupResource) saveGroup(
+	ctx context.Context,
+	data *StatusPageGroupResourceModel,
+	owned []int64,
+	diags *diag.Diagnostics,
+) {
+	sp, err := r.providerData.client.GetStatusPage(ctx, data.StatusPageSlug.ValueString())
+	if err != nil {
+		diags.AddError("failed to read status page", err.Error())
+		return
+	}
+
+	group := statuspage.PublicGroup{
+		Name:        data.Name.ValueString(),
+		Weight:      int(data.Weight.ValueInt64()),
+		MonitorList: []statuspage.PublicMonitor{},
+	}
+	if isKnown(data.ID) {
+		group.ID = data.ID.ValueInt64()
+	}
+
+	if !data.MonitorList.IsNull() {
+		var monitors []PublicMonitorModel
+		diags.Append(data.MonitorList.ElementsAs(ctx, &monitors, false)...)
+		if diags.HasError() {
+			return
+		}
+
+		group.MonitorList = convertMonitorModelsToAPI(monitors)
+	}
+
+	sp.PublicGroupList = []statuspage.PublicGroup{group}
+
+	savedGroups, err := r.providerData.saveStatusPageGroups(ctx, sp, nil, owned)
+	if err != nil {
+		diags.AddError("failed to save status page group", err.Error())
+		return
+	}
+
+	data.ID = types.Int64Value(savedGroups[0].ID)
+}
+
+// publicMonitorListFromAPI converts the monitors of a group read from the
+// public status page API. The API does not tell a custom URL from the URL of
+// the monitor, so url is kept from the state. send_url is only set, if it is
+// set in the state or true.
+func publicMonitorListFromAPI(
+	ctx context.Context,
+	monitors []client.PublicMonitor,
+	stateList types.List,
+	diags *diag.Diagnostics,
+) types.List {
+	if len(monitors) == 0 && stateList.IsNull() {
+		return stateList
+	}
+
+	stateMonitors := map[int64]PublicMonitorModel{}
+
+	if !stateList.IsNull() && !stateList.IsUnknown() {
+		var models []PublicMonitorModel
+		diags.Append(stateList.ElementsAs(ctx, &models, false)...)
+
+		for _, model := range models {
+			stateMonitors[model.ID.ValueInt64()] = model
+		}
+	}
+
+	models := make([]PublicMonitorModel, len(monitors))
+
+	for i, mon := range monitors {
+		model := PublicMonitorModel{
+			ID:      types.Int64Value(mon.ID),
+			SendURL: types.BoolNull(),
+			URL:     types.StringNull(),
+		}
+
+		stateMonitor, ok := stateMonitors[mon.ID]
+		if ok {
+			model.URL = stateMonitor.URL
+		}
+
+		if mon.SendURL || (ok && !stateMonitor.SendURL.IsNull()) {
+			model.SendURL = types.BoolValue(mon.SendURL)
+		}
+
+		models[i] = model
+	}
+
+	monitorList, d := types.ListValueFrom(ctx, publicMonitorAttrType(), models)
+	diags.Append(d...)
+
+	return monitorList
+}
+
+// parseStatusPageGroupID parses an import ID in the format
+// `<status_page_slug>/<id>`.
+func parseStatusPageGroupID(importID string) (string, int64, error) {
+	slug, groupID, ok := strings.Cut(importID, "/")
+	if !ok || slug == "" {
+		return "", 0, fmt.Errorf("missing separator in %q", importID)
+	}
+
+	id, err := strconv.ParseInt(groupID, 10, 64)
+	// Handle error.
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid group ID: %w", err)
+	}
+
+	return slug, id, nil
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
+)
+
+func TestMergeStatusPageGroups(t *testing.T) {
+	current := []client.PublicGroup{
+		{ID: 1, Name: "Team A", Weight: 1, MonitorList: []client.PublicMonitor{{ID: 10}}},
+		{ID: 2, Name: "Team B", Weight: 2, MonitorList: []client.PublicMonitor{{ID: 20, SendURL: true, URL: "https://b"}}},
+		{ID: 3, Name: "Team C", Weight: 3, MonitorList: []client.PublicMonitor{}},
+	}
+
+	names := func(groups []statuspage.PublicGroup) string {
+		result := ""
+		for _, group := range groups {
+			result += fmt.Sprintf("%d:%s:%d ", group.ID, group.Name, group.Weight)
+		}
+
+		return result
+	}
+
+	tests := []struct {
+		name        string
+		owned       []int64
+		groups      []statuspage.PublicGroup
+		want        string
+		wantIndices []int
+	}{
+		{
+			name:        "add group",
+			groups:      []statuspage.PublicGroup{{Name: "Team D"}},
+			want:        "1:Team A:1 2:Team B:2 3:Team C:3 0:Team D:4 ",
+			wantIndices: []int{3},
+		},
+		{
+			name:        "add group with weight",
+			groups:      []statuspage.PublicGroup{{Name: "Team D", Weight: 2}},
+			want:        "1:Team A:1 2:Team B:2 0:Team D:2 3:Team C:3 ",
+			wantIndices: []int{2},
+		},
+		{
+			name:        "update group",
+			owned:       []int64{2},
+			groups:      []statuspage.PublicGroup{{ID: 2, Name: "Team B2"}},
+			want:        "1:Team A:1 2:Team B2:2 3:Team C:3 ",
+			wantIndices: []int{1},
+		},
+		{
+			name:        "remove group",
+			owned:       []int64{2},
+			groups:      []statuspage.PublicGroup{},
+			want:        "1:Team A:1 3:Team C:3 ",
+			wantIndices: []int{},
+		},
+		{
+			name:        "group removed outside of terraform",
+			owned:       []int64{4},
+			groups:      []statuspage.PublicGroup{{ID: 4, Name: "Team E"}},
+			want:        "1:Team A:1 2:Team B:2 3:Team C:3 0:Team E:4 ",
+			wantIndices: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, indices := mergeStatusPageGroups(current, tt.owned, tt.groups)
+
+			if got := names(merged); got != tt.want {
+				t.Errorf("merged groups = %q, want %q", got, tt.want)
+			}
+
+			if fmt.Sprint(indices) != fmt.Sprint(tt.wantIndices) {
+				t.Errorf("indices = %v, want %v", indices, tt.wantIndices)
+			}
+		})
+	}
+
+	// Kept groups are saved with their monitors.
+	merged, _ := mergeStatusPageGroups(current, nil, nil)
+
+	mon := merged[1].MonitorList[0]
+	if mon.ID != 20 || mon.SendURL == nil || !*mon.SendURL || mon.URL == nil || *mon.URL != "https://b" {
+		t.Errorf("monitor of kept group = %+v, want ID 20 with URL", mon)
+	}
+}
+
+func TestPublicMonitorListFromAPI(t *testing.T) {
+	ctx := t.Context()
+
+	monitor := func(id int64, sendURL types.Bool, url types.String) attr.Value {
+		return types.ObjectValueMust(publicMonitorAttrType().AttrTypes, map[string]attr.Value{
+			"id":       types.Int64Value(id),
+			"send_url": sendURL,
+			"url":      url,
+		})
+	}
+
+	stateList := types.ListValueMust(publicMonitorAttrType(), []attr.Value{
+		monitor(1, types.BoolValue(false), types.StringNull()),
+		monitor(2, types.BoolValue(true), types.StringValue("https://custom")),
+	})
+
+	var diags diag.Diagnostics
+
+	got := publicMonitorListFromAPI(ctx, []client.PublicMonitor{
+		{ID: 2, SendURL: true, URL: "https://custom"},
+		{ID: 1},
+		{ID: 3, SendURL: true, URL: "https://monitor"},
+		{ID: 4},
+	}, stateList, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := types.ListValueMust(publicMonitorAttrType(), []attr.Value{
+		monitor(2, types.BoolValue(true), types.StringValue("https://custom")),
+		monitor(1, types.BoolValue(false), types.StringNull()),
+		monitor(3, types.BoolValue(true), types.StringNull()),
+		monitor(4, types.BoolNull(), types.StringNull()),
+	})
+	if !got.Equal(want) {
+		t.Errorf("monitor list = %s, want %s", got, want)
+	}
+
+	got = publicMonitorListFromAPI(ctx, nil, types.ListNull(publicMonitorAttrType()), &diags)
+	if !got.IsNull() {
+		t.Errorf("monitor list = %s, want null", got)
+	}
+}
+
+func TestParseStatusPageGroupID(t *testing.T) {
+	slug, id, err := parseStatusPageGroupID("my-page/42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if slug != "my-page" || id != 42 {
+		t.Errorf("parseStatusPageGroupID() = %q, %d, want %q, %d", slug, id, "my-page", 42)
+	}
+
+	for _, importID := range []string{"42", "/42", "my-page/", "my-page/abc"} {
+		_, _, err := parseStatusPageGroupID(importID)
+		if err == nil {
+			t.Errorf("parseStatusPageGroupID(%q) expected error", importID)
+		}
+	}
+}
+
+func TestAccStatusPageGroupResource(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-group")
+	monitorName := acctest.RandomWithPrefix("test-monitor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageGroupResourceConfig(slug, monitorName, "Team Services"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page_group.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page_group.test",
+						tfjsonpath.New("monitor_list"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+			{
+				// Updating the group keeps the group of the status page
+				// resource and the other way around.
+				Config: testAccStatusPageGroupResourceConfig(slug, monitorName, "Team Services Updated"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page_group.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("Team Services Updated"),
+					),
+				},
+			},
+			{
+				ResourceName:      "uptimekuma_status_page_group.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccStatusPageGroupImportStateID("uptimekuma_status_page_group.test"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"weight",
+				},
+			},
+		},
+	})
+}
+
+func testAccStatusPageGroupImportStateID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["status_page_slug"] + "/" + rs.Primary.Attributes["id"], nil
+	}
+}
+
+func testAccStatusPageGroupResourceConfig(slug string, monitorName string, groupName string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_monitor_http" "test" {
+  name = %[2]q
+  url  = "https://example.com"
+}
+
+resource "uptimekuma_status_page" "test" {
+  slug             = %[1]q
+  title            = "Shared Status Page"
+  exclusive_groups = false
+
+  public_group_list = [
+    {
+      name = "Platform"
+    }
+  ]
+}
+
+resource "uptimekuma_status_page_group" "test" {
+  status_page_slug = uptimekuma_status_page.test.slug
+  name             = %[3]q
+
+  monitor_list = [
+    {
+      id = uptimekuma_monitor_http.test.id
+    }
+  ]
+}
+`, slug, monitorName, groupName)
+}
@@ -2,20 +2,116 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	kuma "github.com/breml/go-uptime-kuma-client"
 	"github.com/breml/go-uptime-kuma-client/statuspage"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
+)
+
+// incidentDateLayout is the layout of the dates of incidents returned by
+// Uptime Kuma. The dates are in UTC.
+const incidentDateLayout = "2006-01-02 15:04:05"
+
+var (
+	_ resource.Resource                = &StatusPageIncidentResource{}
+	_ resource.ResourceWithImportState = &StatusPageIncidentResource{}
+	_ resource.ResourceWithModifyPlan  = &StatusPageIncidentResource{}
 )
 
-var _ resource.Resource = &StatusPageIncidentResource{}
+// incidentExpiresAtValidator validates, that the value is a RFC3339 timestamp.
+type incidentExpiresAtValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (incidentExpiresAtValidator) Description(_ context.Context) string {
+	return "string must be a RFC3339 timestamp"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v incidentExpiresAtValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the provided string value is a RFC3339 timestamp.
+func (incidentExpiresAtValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(
+			diag.NewAttributeErrorDiagnostic(
+				req.Path,
+				"Invalid Timestamp",
+				fmt.Sprintf(
+					"Attribute must be a RFC3339 timestamp (e.g. 2026-01-02T15:04:05Z), got: %s",
+					req.ConfigValue.ValueString(),
+				),
+			),
+		)
+	}
+}
+
+// incidentTTLValidator validates, that the value is a positive duration.
+type incidentTTLValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (incidentTTLValidator) Description(_ context.Context) string {
+	return "string must be a positive duration, e.g. 30m or 4h"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (incidentTTLValidator) MarkdownDescription(_ context.Context) string {
+	return "string must be a positive duration, e.g. `30m` or `4h`"
+}
+
+// ValidateString checks that the provided string value is a positive duration.
+func (incidentTTLValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	ttl, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || ttl <= 0 {
+		resp.Diagnostics.Append(
+			diag.NewAttributeErrorDiagnostic(
+				req.Path,
+				"Invalid Duration",
+				fmt.Sprintf(
+					"Attribute must be a positive duration (e.g. 30m or 4h), got: %s",
+					req.ConfigValue.ValueString(),
+				),
+			),
+		)
+	}
+}
 
 // NewStatusPageIncidentResource returns a new instance of the status page incident resource.
 func NewStatusPageIncidentResource() resource.Resource {
@@ -24,27 +120,37 @@ func NewStatusPageIncidentResource() resource.Resource {
 
 // StatusPageIncidentResource manages incidents on status pages.
 //
-// NOTE: The Uptime Kuma API and client have limited incident management capabilities:
-//   - No GetIncident method: The Read operation cannot fetch incident state from the API,
-//     so drift detection is limited. The resource maintains state but cannot verify it.
-//   - No DeleteIncident method: The Delete operation can only unpin incidents. Incidents
-//     persist on the status page after Terraform destroys the resource.
-//   - PostIncident handles both create and update operations using the incident ID.
+// NOTE: Uptime Kuma 1.x only exposes the incident currently pinned on a
+// status page and neither version has a way to delete incidents:
+//   - Read detects changes of the pinned incident. Incidents, which are no
+//     longer pinned, are read from the incident history of Uptime Kuma 2.x.
+//     Without the history, `pin` is read as false, e.g. because the incident
+//     was unpinned or replaced in the UI.
+//   - Delete unpins the incident, if it is still pinned. The incident persists
+//     in the database of Uptime Kuma.
+//   - The postIncident event always pins the incident, so it is unpinned
+//     right away, if `pin` is false or the incident is expired.
 //
-// These limitations mean this resource provides best-effort management of incidents
-// but cannot guarantee full CRUD semantics or accurate drift detection.
+// Expiry is evaluated at plan time: once `expires_at` or `created_date` plus
+// `ttl` has passed, the plan changes `expired` to true and the apply unpins
+// the incident.
 type StatusPageIncidentResource struct {
-	client *kuma.Client
+	providerData *providerData
 }
 
 // StatusPageIncidentResourceModel describes the resource data model.
 type StatusPageIncidentResourceModel struct {
-	ID             types.Int64  `tfsdk:"id"`
-	StatusPageSlug types.String `tfsdk:"status_page_slug"`
-	Title          types.String `tfsdk:"title"`
-	Content        types.String `tfsdk:"content"`
-	Style          types.String `tfsdk:"style"`
-	Pin            types.Bool   `tfsdk:"pin"`
+	ID              types.Int64  `tfsdk:"id"`
+	StatusPageSlug  types.String `tfsdk:"status_page_slug"`
+	Title           types.String `tfsdk:"title"`
+	Content         types.String `tfsdk:"content"`
+	Style           types.String `tfsdk:"style"`
+	Pin             types.Bool   `tfsdk:"pin"`
+	CreatedDate     types.String `tfsdk:"created_date"`
+	LastUpdatedDate types.String `tfsdk:"last_updated_date"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+	TTL             types.String `tfsdk:"ttl"`
+	Expired         types.Bool   `tfsdk:"expired"`
 }
 
 // Metadata returns the metadata for the resource.
@@ -63,7 +169,10 @@ func (*StatusPageIncidentResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Status page incident resource",
+		MarkdownDescription: "Status page incident resource. Incidents, which are not pinned, are read from the " +
+			"incident history of the status page. Uptime Kuma 1.x has no incident history, so changes made in " +
+			"the UI are only detected while the incident is pinned. Destroying the resource unpins the incident, " +
+			"Uptime Kuma does not support deleting incidents.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Incident ID",
@@ -92,10 +201,44 @@ func (*StatusPageIncidentResource) Schema(
 				Optional:            true,
 			},
 			"pin": schema.BoolAttribute{
-				MarkdownDescription: "Pin incident to top of status page",
-				Optional:            true,
+				MarkdownDescription: "Pin incident to top of status page. Only a pinned incident is shown on the " +
+					"status page and pinning an incident unpins all other incidents of the status page",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"created_date": schema.StringAttribute{
+				MarkdownDescription: "Creation date of the incident (RFC3339)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_date": schema.StringAttribute{
+				MarkdownDescription: "Date of the last update of the incident (RFC3339)",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Date (RFC3339) after which the incident is unpinned. Expiry is evaluated " +
+					"at plan time, so the incident is unpinned by the first apply after this date",
+				Optional: true,
+				Validators: []validator.String{
+					incidentExpiresAtValidator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("ttl")),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "Duration (e.g. `30m` or `4h`) after the creation of the incident, after " +
+					"which the incident is unpinned. Expiry is evaluated at plan time, so the incident is unpinned " +
+					"by the first apply after this duration",
+				Optional: true,
+				Validators: []validator.String{
+					incidentTTLValidator{},
+				},
+			},
+			"expired": schema.BoolAttribute{
+				MarkdownDescription: "Whether the incident is expired according to `expires_at` or `ttl`",
 				Computed:            true,
-				Default:             booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -107,7 +250,35 @@ func (r *StatusPageIncidentResource) Configure(
 	req resource.ConfigureRequest,
 	resp *resource.ConfigureResponse,
 ) {
-	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+	r.providerData = configureProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// ModifyPlan sets `expired` to true, once `expires_at` or `created_date` plus
+// `ttl` has passed, which causes the incident to be unpinned.
+func (*StatusPageIncidentResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan StatusPageIncidentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expired := types.BoolUnknown()
+	if !plan.ExpiresAt.IsUnknown() && !plan.TTL.IsUnknown() {
+		expiry, ok := incidentExpiry(plan)
+		expired = types.BoolValue(ok && !time.Now().Before(expiry))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expired"), expired)...)
 }
 
 // Create creates a new resource.
@@ -124,26 +295,17 @@ func (r *StatusPageIncidentResource) Create(
 		return
 	}
 
-	incident := &statuspage.Incident{
-		Title:   data.Title.ValueString(),
-		Content: data.Content.ValueString(),
-		Style:   data.Style.ValueString(),
-		Pin:     data.Pin.ValueBool(),
-	}
-
-	err := r.client.PostIncident(ctx, data.StatusPageSlug.ValueString(), incident)
+	err := r.postIncident(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create incident", err.Error())
 		return
 	}
 
-	data.ID = types.Int64Value(incident.ID)
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Read reads the current state of the resource.
-func (*StatusPageIncidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+func (r *StatusPageIncidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data StatusPageIncidentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -152,6 +314,48 @@ func (*StatusPageIncidentResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	incident, err := r.getIncident(ctx, data.StatusPageSlug.ValueString(), data.ID.ValueInt64())
+	if err != nil {
+		// Handle resource not found error.
+		if errors.Is(err, client.ErrStatusPageNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("failed to read incident", err.Error())
+		return
+	}
+
+	if data.Expired.IsNull() {
+		data.Expired = types.BoolValue(false)
+	}
+
+	if incident == nil {
+		// Without the incident history, only the pinned incident is available.
+		// Without a title, the resource is imported and the incident can not
+		// be read.
+		if data.Title.IsNull() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		// Expired incidents are expected to be unpinned.
+		if !data.Expired.ValueBool() {
+			data.Pin = types.BoolValue(false)
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	pin := data.Pin
+	populateStatusPageIncident(&data, *incident)
+
+	// Expired incidents are expected to be unpinned.
+	if data.Expired.ValueBool() && !incident.Pin && !pin.IsNull() {
+		data.Pin = pin
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -168,15 +372,7 @@ func (r *StatusPageIncidentResource) Update(
 		return
 	}
 
-	incident := &statuspage.Incident{
-		ID:      data.ID.ValueInt64(),
-		Title:   data.Title.ValueString(),
-		Content: data.Content.ValueString(),
-		Style:   data.Style.ValueString(),
-		Pin:     data.Pin.ValueBool(),
-	}
-
-	err := r.client.PostIncident(ctx, data.StatusPageSlug.ValueString(), incident)
+	err := r.postIncident(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update incident", err.Error())
 		return
@@ -199,11 +395,216 @@ func (r *StatusPageIncidentResource) Delete(
 		return
 	}
 
-	if data.Pin.ValueBool() {
-		err := r.client.UnpinIncident(ctx, data.StatusPageSlug.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("failed to unpin incident", err.Error())
+	incident, err := r.getPinnedIncident(ctx, data.StatusPageSlug.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrStatusPageNotFound) {
 			return
 		}
+
+		resp.Diagnostics.AddError("failed to read incident", err.Error())
+		return
+	}
+
+	// Do not unpin an incident, which replaced this one.
+	if incident == nil || incident.ID != data.ID.ValueInt64() {
+		return
+	}
+
+	err = r.providerData.client.UnpinIncident(ctx, data.StatusPageSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to unpin incident", err.Error())
+		return
+	}
+}
+
+// ImportState imports an existing resource by `<status_page_slug>/<id>`.
+// With Uptime Kuma 1.x, only the incident currently pinned on the status page
+// can be imported, other incidents are read from the incident history.
+func (*StatusPageIncidentResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	slug, id, err := parseStatusPageIncidentID(req.ID)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in the format <status_page_slug>/<id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	// Populate state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_slug"), slug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// postIncident creates or, if the ID is known, updates the incident and
+// populates the ID and the dates. The incident is unpinned, if `pin` is
+// false, because Uptime Kuma pins every posted incident.
+func (r *StatusPageIncidentResource) postIncident(ctx context.Context, data *StatusPageIncidentResourceModel) error {
+	session, err := r.providerData.openSession(ctx)
+	// Handle error.
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = session.Close()
+	}()
+
+	incident := statuspage.Incident{
+		Title:   data.Title.ValueString(),
+		Content: data.Content.ValueString(),
+		Style:   data.Style.ValueString(),
+		Pin:     data.Pin.ValueBool(),
+	}
+	if !data.ID.IsNull() && !data.ID.IsUnknown() {
+		incident.ID = data.ID.ValueInt64()
+	}
+
+	var res struct {
+		Incident client.Incident `json:"incident"`
+	}
+
+	_, err = session.Call(ctx, "postIncident", &res, data.StatusPageSlug.ValueString(), incident)
+	// Handle error.
+	if err != nil {
+		return err
+	}
+
+	if data.Expired.IsUnknown() {
+		expiry, ok := incidentExpiry(*data)
+		data.Expired = types.BoolValue(ok && !time.Now().Before(expiry))
+	}
+
+	if !data.Pin.ValueBool() || data.Expired.ValueBool() {
+		_, err = session.Emit(ctx, "unpinIncident", data.StatusPageSlug.ValueString())
+		// Handle error.
+		if err != nil {
+			return err
+		}
+	}
+
+	data.ID = types.Int64Value(res.Incident.ID)
+	if data.CreatedDate.IsUnknown() || data.CreatedDate.IsNull() {
+		data.CreatedDate = incidentDate(res.Incident.CreatedDate)
+	}
+
+	data.LastUpdatedDate = incidentDate(res.Incident.LastUpdatedDate)
+
+	return nil
+}
+
+// incidentExpiry returns the time, after which the incident is expired. It
+// returns false, if the incident does not expire or the time is not known
+// yet, e.g. because the incident is not yet created.
+func incidentExpiry(data StatusPageIncidentResourceModel) (time.Time, bool) {
+	if isKnown(data.ExpiresAt) {
+		expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+
+		return expiresAt, err == nil
+	}
+
+	if isKnown(data.TTL) && isKnown(data.CreatedDate) {
+		ttl, err := time.ParseDuration(data.TTL.ValueString())
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, data.CreatedDate.ValueString())
+		if err != nil {
+			return time.Time{}, false
+		}
+
+		return createdDate.Add(ttl), true
 	}
+
+	return time.Time{}, false
+}
+
+// getPinnedIncident returns the incident pinned on the status page or nil.
+func (r *StatusPageIncidentResource) getPinnedIncident(ctx context.Context, slug string) (*client.Incident, error) {
+	if r.providerData.clientConfig == nil {
+		return nil, errors.New("provider connection settings are not available")
+	}
+
+	return client.GetStatusPageIncident(ctx, r.providerData.clientConfig, slug)
+}
+
+// getIncident returns the incident with the given ID or nil, if it is not
+// found. Incidents, which are not pinned, are looked up in the incident
+// history. Uptime Kuma 1.x has no incident history, in which case only the
+// pinned incident is found.
+func (r *StatusPageIncidentResource) getIncident(ctx context.Context, slug string, id int64) (*client.Incident, error) {
+	incident, err := r.getPinnedIncident(ctx, slug)
+	// Handle error.
+	if err != nil {
+		return nil, err
+	}
+
+	if incident != nil && incident.ID == id {
+		incident.Pin = true
+		return incident, nil
+	}
+
+	incidents, err := client.GetStatusPageIncidentHistory(ctx, r.providerData.clientConfig, slug)
+	if err != nil {
+		tflog.Debug(ctx, "incident history not available, only the pinned incident is read", map[string]any{
+			"status_page_slug": slug,
+			"error":            err.Error(),
+		})
+
+		return nil, nil
+	}
+
+	idx := slices.IndexFunc(incidents, func(i client.Incident) bool { return i.ID == id })
+	if idx < 0 {
+		return nil, nil
+	}
+
+	return &incidents[idx], nil
+}
+
+// populateStatusPageIncident populates the model from the incident.
+func populateStatusPageIncident(data *StatusPageIncidentResourceModel, incident client.Incident) {
+	data.Title = types.StringValue(incident.Title)
+	data.Content = types.StringValue(incident.Content)
+	data.Style = stringOrNullPreserveEmpty(incident.Style, data.Style)
+	data.Pin = types.BoolValue(incident.Pin)
+	data.CreatedDate = incidentDate(incident.CreatedDate)
+	data.LastUpdatedDate = incidentDate(incident.LastUpdatedDate)
+}
+
+// incidentDate converts a date of an incident to RFC3339. Dates in an
+// unexpected format are returned unchanged.
+func incidentDate(date string) types.String {
+	if date == "" {
+		return types.StringNull()
+	}
+
+	t, err := time.ParseInLocation(incidentDateLayout, date, time.UTC)
+	if err != nil {
+		return types.StringValue(date)
+	}
+
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// parseStatusPageIncidentID parses an import ID in the format
+// `<status_page_slug>/<id>`.
+func parseStatusPageIncidentID(importID string) (string, int64, error) {
+	slug, incidentID, ok := strings.Cut(importID, "/")
+	if !ok || slug == "" {
+		return "", 0, fmt.Errorf("missing separator in %q", importID)
+	}
+
+	id, err := strconv.ParseInt(incidentID, 10, 64)
+	// Handle error.
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid incident ID: %w", err)
+	}
+
+	return slug, id, nil
 }
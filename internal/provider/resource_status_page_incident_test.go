@@ -3,11 +3,16 @@ package provider
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
@@ -110,6 +115,20 @@ func TestAccStatusPageIncidentResourceWithStyle(t *testing.T) {
 						tfjsonpath.New("pin"),
 						knownvalue.Bool(true),
 					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page_incident.test",
+						tfjsonpath.New("created_date"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				ResourceName:      "uptimekuma_status_page_incident.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["uptimekuma_status_page_incident.test"]
+					return rs.Primary.Attributes["status_page_slug"] + "/" + rs.Primary.Attributes["id"], nil
 				},
 			},
 		},
@@ -136,6 +155,55 @@ resource "uptimekuma_status_page_incident" "test" {
 `, slug, statusPageTitle, incidentTitle, incidentContent)
 }
 
+func TestAccStatusPageIncidentResourceUnpinned(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-incident-unpinned")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageIncidentResourceConfigUnpinned(slug),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page_incident.test",
+						tfjsonpath.New("pin"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				// The incident, which is not pinned, is read from the incident history.
+				ResourceName:      "uptimekuma_status_page_incident.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["uptimekuma_status_page_incident.test"]
+					return rs.Primary.Attributes["status_page_slug"] + "/" + rs.Primary.Attributes["id"], nil
+				},
+			},
+		},
+	})
+}
+
+func testAccStatusPageIncidentResourceConfigUnpinned(slug string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_status_page" "test" {
+  slug      = %[1]q
+  title     = "Test Status Page"
+  published = true
+}
+
+resource "uptimekuma_status_page_incident" "test" {
+  status_page_slug = uptimekuma_status_page.test.slug
+  title            = "Unpinned Incident"
+  content          = "This incident is not pinned"
+  style            = "warning"
+  pin              = false
+}
+`, slug)
+}
+
 func TestAccStatusPageIncidentResourceUpdate(t *testing.T) {
 	slug := acctest.RandomWithPrefix("test-incident-update")
 	statusPageTitle := "Test Status Page"
@@ -191,3 +259,195 @@ func TestAccStatusPageIncidentResourceUpdate(t *testing.T) {
 		},
 	})
 }
+
+func TestIncidentDate(t *testing.T) {
+	tests := []struct {
+		name string
+		date string
+		want types.String
+	}{
+		{name: "empty", date: "", want: types.StringNull()},
+		{name: "utc", date: "2026-01-02 03:04:05", want: types.StringValue("2026-01-02T03:04:05Z")},
+		{name: "unexpected format", date: "yesterday", want: types.StringValue("yesterday")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := incidentDate(tt.date)
+			if !got.Equal(tt.want) {
+				t.Errorf("incidentDate(%q) = %s, want %s", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStatusPageIncidentID(t *testing.T) {
+	slug, id, err := parseStatusPageIncidentID("my-page/42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if slug != "my-page" || id != 42 {
+		t.Errorf("parseStatusPageIncidentID() = %q, %d, want %q, %d", slug, id, "my-page", 42)
+	}
+
+	for _, importID := range []string{"42", "/42", "my-page/", "my-page/abc"} {
+		_, _, err := parseStatusPageIncidentID(importID)
+		if err == nil {
+			t.Errorf("parseStatusPageIncidentID(%q) expected error", importID)
+		}
+	}
+}
+
+func TestAccStatusPageIncidentResourceExpired(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-incident-expired")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageIncidentResourceConfigWithExpiry(slug, "2099-01-01T00:00:00Z"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page_incident.test",
+						tfjsonpath.New("expired"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				Config: testAccStatusPageIncidentResourceConfigWithExpiry(slug, "2020-01-01T00:00:00Z"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page_incident.test",
+						tfjsonpath.New("expired"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page_incident.test",
+						tfjsonpath.New("pin"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.uptimekuma_status_page_incidents.test",
+						tfjsonpath.New("incidents").AtSliceIndex(0).AtMapKey("pin"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccStatusPageIncidentResourceConfigWithExpiry(slug string, expiresAt string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_status_page" "test" {
+  slug      = %[1]q
+  title     = "Test Status Page"
+  published = true
+}
+
+resource "uptimekuma_status_page_incident" "test" {
+  status_page_slug = uptimekuma_status_page.test.slug
+  title            = "Expiring Incident"
+  content          = "This incident expires"
+  pin              = true
+  expires_at       = %[2]q
+}
+
+data "uptimekuma_status_page_incidents" "test" {
+  status_page_slug = uptimekuma_status_page.test.slug
+
+  depends_on = [uptimekuma_status_page_incident.test]
+}
+`, slug, expiresAt)
+}
+
+func TestIncidentExpiry(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresAt types.String
+		ttl       types.String
+		created   types.String
+		want      time.Time
+		wantOK    bool
+	}{
+		{
+			name:      "no expiry",
+			expiresAt: types.StringNull(),
+			ttl:       types.StringNull(),
+			created:   types.StringValue("2026-01-02T03:04:05Z"),
+		},
+		{
+			name:      "expires_at",
+			expiresAt: types.StringValue("2026-01-02T10:00:00+02:00"),
+			ttl:       types.StringNull(),
+			created:   types.StringUnknown(),
+			want:      time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC),
+			wantOK:    true,
+		},
+		{
+			name:      "ttl",
+			expiresAt: types.StringNull(),
+			ttl:       types.StringValue("4h"),
+			created:   types.StringValue("2026-01-02T03:04:05Z"),
+			want:      time.Date(2026, 1, 2, 7, 4, 5, 0, time.UTC),
+			wantOK:    true,
+		},
+		{
+			name:      "ttl before creation",
+			expiresAt: types.StringNull(),
+			ttl:       types.StringValue("4h"),
+			created:   types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := incidentExpiry(StatusPageIncidentResourceModel{
+				ExpiresAt:   tt.expiresAt,
+				TTL:         tt.ttl,
+				CreatedDate: tt.created,
+			})
+			if ok != tt.wantOK || !got.Equal(tt.want) {
+				t.Errorf("incidentExpiry() = %s, %t, want %s, %t", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestIncidentExpiryValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantErr   bool
+	}{
+		{name: "expires_at null", validator: incidentExpiresAtValidator{}, value: types.StringNull()},
+		{name: "expires_at valid", validator: incidentExpiresAtValidator{}, value: types.StringValue("2026-01-02T15:04:05Z")},
+		{
+			name:      "expires_at invalid",
+			validator: incidentExpiresAtValidator{},
+			value:     types.StringValue("2026-01-02 15:04:05"),
+			wantErr:   true,
+		},
+		{name: "ttl unknown", validator: incidentTTLValidator{}, value: types.StringUnknown()},
+		{name: "ttl valid", validator: incidentTTLValidator{}, value: types.StringValue("1h30m")},
+		{name: "ttl invalid", validator: incidentTTLValidator{}, value: types.StringValue("1 day"), wantErr: true},
+		{name: "ttl negative", validator: incidentTTLValidator{}, value: types.StringValue("-1h"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("value"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			tt.validator.ValidateString(t.Context(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("expected error: %t, got diagnostics: %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/breml/go-uptime-kuma-client/monitor"
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+func TestMonitorSelectorMatches(t *testing.T) {
+	var monitors []monitor.Base
+
+	err := json.Unmarshal([]byte(`[
+		{"id": 1, "type": "http", "tags": [{"tag_id": 1, "value": "prod"}]},
+		{"id": 2, "type": "ping", "parent": 10, "tags": [{"tag_id": 1, "value": "dev"}]},
+		{"id": 3, "type": "http", "parent": 10},
+		{"id": 4, "type": "http", "parent": 11, "tags": [{"tag_id": 2, "value": "prod"}]}
+	]`), &monitors)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	selector := func(
+		tagID types.Int64,
+		tagValue types.String,
+		monitorType types.String,
+		parent types.Int64,
+	) MonitorSelectorModel {
+		return MonitorSelectorModel{
+			TagID:    tagID,
+			TagName:  types.StringNull(),
+			TagValue: tagValue,
+			Type:     monitorType,
+			Parent:   parent,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		selector MonitorSelectorModel
+		want     []int64
+	}{
+		{
+			name:     "tag",
+			selector: selector(types.Int64Value(1), types.StringNull(), types.StringNull(), types.Int64Null()),
+			want:     []int64{1, 2},
+		},
+		{
+			name:     "tag with value",
+			selector: selector(types.Int64Value(1), types.StringValue("prod"), types.StringNull(), types.Int64Null()),
+			want:     []int64{1},
+		},
+		{
+			name:     "type",
+			selector: selector(types.Int64Null(), types.StringNull(), types.StringValue("http"), types.Int64Null()),
+			want:     []int64{1, 3, 4},
+		},
+		{
+			name:     "type and parent",
+			selector: selector(types.Int64Null(), types.StringNull(), types.StringValue("http"), types.Int64Value(10)),
+			want:     []int64{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []int64{}
+
+			for _, mon := range monitors {
+				if monitorSelectorMatches(mon, tt.selector, tt.selector.TagID.ValueInt64()) {
+					got = append(got, mon.ID)
+				}
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("matched monitors = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonitorSelectorResolverResolveTag(t *testing.T) {
+	resolver := &monitorSelectorResolver{
+		tags: []tag.Tag{
+			{ID: 1, Name: "tier"},
+			{ID: 2, Name: "team"},
+			{ID: 3, Name: "team"},
+		},
+	}
+
+	byName := func(name string) MonitorSelectorModel {
+		return MonitorSelectorModel{TagID: types.Int64Null(), TagName: types.StringValue(name)}
+	}
+
+	var diags diag.Diagnostics
+
+	id, found := resolver.resolveTag(byName("tier"), &diags)
+	if diags.HasError() || !found || id != 1 {
+		t.Errorf("resolveTag(tier) = %d, %t, %v, want 1, true", id, found, diags)
+	}
+
+	_, found = resolver.resolveTag(byName("missing"), &diags)
+	if diags.HasError() || found {
+		t.Errorf("resolveTag(missing) = %t, %v, want false without error", found, diags)
+	}
+
+	_, found = resolver.resolveTag(
+		MonitorSelectorModel{TagID: types.Int64Value(4), TagName: types.StringNull()},
+		&diags,
+	)
+	if diags.HasError() || found {
+		t.Errorf("resolveTag(4) = %t, %v, want false without error", found, diags)
+	}
+
+	_, _ = resolver.resolveTag(byName("team"), &diags)
+	if !diags.HasError() {
+		t.Error("resolveTag(team) expected ambiguous tag error")
+	}
+}
+
+func TestMonitorSelectorValidator(t *testing.T) {
+	selector := func(values map[string]attr.Value) types.Object {
+		attrs := map[string]attr.Value{
+			"tag_id":    types.Int64Null(),
+			"tag_name":  types.StringNull(),
+			"tag_value": types.StringNull(),
+			"type":      types.StringNull(),
+			"parent":    types.Int64Null(),
+		}
+		for k, v := range values {
+			attrs[k] = v
+		}
+
+		return types.ObjectValueMust(monitorSelectorAttrTypes(), attrs)
+	}
+
+	tests := []struct {
+		name    string
+		value   types.Object
+		wantErr bool
+	}{
+		{name: "null", value: types.ObjectNull(monitorSelectorAttrTypes())},
+		{name: "tag name", value: selector(map[string]attr.Value{"tag_name": types.StringValue("tier")})},
+		{
+			name: "tag with value",
+			value: selector(map[string]attr.Value{
+				"tag_id":    types.Int64Value(1),
+				"tag_value": types.StringValue("prod"),
+			}),
+		},
+		{name: "unknown parent", value: selector(map[string]attr.Value{"parent": types.Int64Unknown()})},
+		{name: "empty", value: selector(nil), wantErr: true},
+		{
+			name: "value without tag",
+			value: selector(map[string]attr.Value{
+				"type":      types.StringValue("http"),
+				"tag_value": types.StringValue("prod"),
+			}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.ObjectRequest{
+				Path:        path.Root("public_group_list").AtListIndex(0).AtName("monitor_selector"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.ObjectResponse{}
+
+			monitorSelectorValidator{}.ValidateObject(t.Context(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("expected error: %t, got diagnostics: %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestMarkSelectedMonitorsUnknown(t *testing.T) {
+	ctx := t.Context()
+
+	selector := types.ObjectValueMust(monitorSelectorAttrTypes(), map[string]attr.Value{
+		"tag_id":    types.Int64Null(),
+		"tag_name":  types.StringNull(),
+		"tag_value": types.StringNull(),
+		"type":      types.StringValue("http"),
+		"parent":    types.Int64Null(),
+	})
+
+	group := func(name string, selector types.Object, selected types.List) PublicGroupModel {
+		return PublicGroupModel{
+			ID:                 types.Int64Value(1),
+			Name:               types.StringValue(name),
+			Weight:             types.Int64Null(),
+			MonitorList:        types.ListNull(publicMonitorAttrType()),
+			MonitorSelector:    selector,
+			SelectedMonitorIDs: selected,
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	groupList := buildGroupListFromModels(ctx, []PublicGroupModel{
+		group("selected", selector, types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(3)})),
+		group("listed", types.ObjectNull(monitorSelectorAttrTypes()), types.ListUnknown(types.Int64Type)),
+	}, &diags)
+
+	var groups []PublicGroupModel
+
+	diags.Append(markSelectedMonitorsUnknown(ctx, groupList, &diags).ElementsAs(ctx, &groups, false)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !groups[0].SelectedMonitorIDs.IsUnknown() {
+		t.Errorf("selected_monitor_ids of group with selector = %s, want unknown", groups[0].SelectedMonitorIDs)
+	}
+
+	if !groups[1].SelectedMonitorIDs.IsNull() {
+		t.Errorf("selected_monitor_ids of group without selector = %s, want null", groups[1].SelectedMonitorIDs)
+	}
+
+	// On apply, known selected monitors are kept without reading the monitors.
+	resolver := &monitorSelectorResolver{}
+
+	resolved := resolver.resolveGroupList(ctx, groupList, true, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	diags.Append(resolved.ElementsAs(ctx, &groups, false)...)
+
+	if !groups[0].SelectedMonitorIDs.Equal(types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(3)})) {
+		t.Errorf("selected_monitor_ids = %s, want [3]", groups[0].SelectedMonitorIDs)
+	}
+}
+
+func TestAccStatusPageResourceWithMonitorSelector(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-selector")
+	tagName := acctest.RandomWithPrefix("TestSelectorTag")
+	monitorName := acctest.RandomWithPrefix("test-monitor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageResourceConfigWithMonitorSelector(slug, tagName, monitorName, 2),
+				// The monitors are created in the same apply, so they are
+				// only matched by the next plan.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStatusPageResourceConfigWithMonitorSelector(slug, tagName, monitorName, 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page.test",
+						tfjsonpath.New("public_group_list").AtSliceIndex(0).AtMapKey("selected_monitor_ids"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page.test",
+						tfjsonpath.New("public_group_list").AtSliceIndex(0).AtMapKey("monitor_list"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+			{
+				// A new matching monitor shows up as drift.
+				Config:             testAccStatusPageResourceConfigWithMonitorSelector(slug, tagName, monitorName, 3),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStatusPageResourceConfigWithMonitorSelector(slug, tagName, monitorName, 3),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page.test",
+						tfjsonpath.New("public_group_list").AtSliceIndex(0).AtMapKey("selected_monitor_ids"),
+						knownvalue.ListSizeExact(2),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccStatusPageResourceConfigWithMonitorSelector(
+	slug string,
+	tagName string,
+	monitorName string,
+	monitorCount int,
+) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_tag" "public" {
+  name  = %[2]q
+  color = "#6b7280"
+}
+
+resource "uptimekuma_monitor_http" "test" {
+  count = %[4]d
+
+  name = "%[3]s-${count.index}"
+  url  = "https://example.com/${count.index}"
+
+  tags = [
+    {
+      tag_id = uptimekuma_tag.public.id
+      value  = "public"
+    }
+  ]
+}
+
+resource "uptimekuma_status_page" "test" {
+  slug      = %[1]q
+  title     = "Status Page with Monitor Selector"
+  published = true
+
+  public_group_list = [
+    {
+      name = "Public Services"
+      monitor_list = [
+        {
+          id = uptimekuma_monitor_http.test[0].id
+        }
+      ]
+      monitor_selector = {
+        tag_name  = uptimekuma_tag.public.name
+        tag_value = "public"
+      }
+    }
+  ]
+}
+`, slug, tagName, monitorName, monitorCount)
+}
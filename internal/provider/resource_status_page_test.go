@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -73,6 +74,19 @@ func TestAccStatusPageResource(t *testing.T) {
 					),
 				},
 			},
+			{
+				ResourceName:                         "uptimekuma_status_page.test",
+				ImportState:                          true,
+				ImportStateId:                        slug,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "slug",
+				ImportStateVerifyIgnore: []string{
+					"published",
+					"show_tags",
+					"show_powered_by",
+					"show_certificate_expiry",
+				},
+			},
 		},
 	})
 }
@@ -289,6 +303,122 @@ resource "uptimekuma_status_page" "test" {
 `, slug, title)
 }
 
+func TestAccStatusPageResourceAutoRefreshInterval(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-refresh")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageResourceConfigWithAutoRefreshInterval(slug, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page.test",
+						tfjsonpath.New("auto_refresh_interval"),
+						knownvalue.Int64Exact(300),
+					),
+				},
+			},
+			{
+				Config: testAccStatusPageResourceConfigWithAutoRefreshInterval(slug, "auto_refresh_interval = 60"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page.test",
+						tfjsonpath.New("auto_refresh_interval"),
+						knownvalue.Int64Exact(60),
+					),
+				},
+			},
+			{
+				// Saving a group must not reset the interval of the status page.
+				Config: testAccStatusPageResourceConfigWithAutoRefreshIntervalAndGroup(slug),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page.test",
+						tfjsonpath.New("auto_refresh_interval"),
+						knownvalue.Int64Exact(60),
+					),
+				},
+			},
+			{
+				Config:   testAccStatusPageResourceConfigWithAutoRefreshIntervalAndGroup(slug),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:                         "uptimekuma_status_page.test",
+				ImportState:                          true,
+				ImportStateId:                        slug,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "slug",
+				ImportStateVerifyIgnore:              []string{"public_group_list", "exclusive_groups"},
+			},
+		},
+	})
+}
+
+func TestAccStatusPageResourceInvalidAnalyticsType(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-analytics")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_status_page" "test" {
+  slug           = %[1]q
+  title          = "Invalid Analytics"
+  analytics_type = "statcounter"
+}
+`, slug),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestAccStatusPageResourceInvalidTheme(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-theme")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_status_page" "test" {
+  slug  = %[1]q
+  title = "Invalid Theme"
+  theme = "solarized"
+}
+`, slug),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func testAccStatusPageResourceConfigWithAutoRefreshInterval(slug string, interval string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_status_page" "test" {
+  slug             = %[1]q
+  title            = "Status Page with Auto Refresh"
+  exclusive_groups = false
+  %[2]s
+}
+`, slug, interval)
+}
+
+func testAccStatusPageResourceConfigWithAutoRefreshIntervalAndGroup(slug string) string {
+	return testAccStatusPageResourceConfigWithAutoRefreshInterval(slug, "auto_refresh_interval = 60") + `
+resource "uptimekuma_status_page_group" "test" {
+  status_page_slug = uptimekuma_status_page.test.slug
+  name             = "Shared Group"
+}
+`
+}
+
 func TestAccStatusPageResourceWithIcon(t *testing.T) {
 	slug := acctest.RandomWithPrefix("test-icon")
 	title := "Status Page with Icon"
@@ -0,0 +1,681 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	writeOnlySuffix        = "_wo"
+	writeOnlyVersionSuffix = "_wo_version"
+)
+
+var (
+	_ resource.ResourceWithConfigure        = &writeOnlySecretsResource{}
+	_ resource.ResourceWithImportState      = &writeOnlySecretsResource{}
+	_ resource.ResourceWithConfigValidators = &writeOnlySecretsResource{}
+	_ resource.ResourceWithValidateConfig   = &writeOnlySecretsResource{}
+	_ resource.ResourceWithModifyPlan       = &writeOnlySecretsResource{}
+	_ resource.ResourceWithMoveState        = &writeOnlySecretsResource{}
+)
+
+// writeOnlySecretsResource wraps a resource and offers a write-only variant
+// `<name>_wo` together with a `<name>_wo_version` trigger for each sensitive
+// string attribute of the wrapped resource. The wrapped resource is not
+// aware of these attributes: they are stripped from all requests, and the
+// write-only value is passed in place of the original attribute instead.
+// The original attribute is kept null in plan and state, so the secret is
+// never persisted.
+type writeOnlySecretsResource struct {
+	resource.Resource
+}
+
+// withWriteOnlySecrets wraps the resources returned by the factories with
+// writeOnlySecretsResource.
+func withWriteOnlySecrets(factories []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, 0, len(factories))
+	for _, factory := range factories {
+		wrapped = append(wrapped, func() resource.Resource {
+			return &writeOnlySecretsResource{Resource: factory()}
+		})
+	}
+
+	return wrapped
+}
+
+// writeOnlySecretNames returns the names of the attributes, for which a
+// write-only variant is offered.
+func writeOnlySecretNames(attrs map[string]schema.Attribute) []string {
+	var names []string
+
+	for name, attr := range attrs {
+		stringAttr, ok := attr.(schema.StringAttribute)
+		if !ok || !stringAttr.Sensitive || stringAttr.Computed || stringAttr.WriteOnly {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// withWriteOnlySecretAttributes returns a copy of attrs with the write-only
+// variant and the version attribute added for each secret. Required secrets
+// become optional, since exactly one of the secret and its write-only
+// variant needs to be configured.
+func withWriteOnlySecretAttributes(attrs map[string]schema.Attribute, secrets []string) map[string]schema.Attribute {
+	result := make(map[string]schema.Attribute, len(attrs)+2*len(secrets))
+	for name, attr := range attrs {
+		result[name] = attr
+	}
+
+	for _, name := range secrets {
+		attr, _ := attrs[name].(schema.StringAttribute)
+		writeOnlyName := name + writeOnlySuffix
+		versionName := name + writeOnlyVersionSuffix
+
+		writeOnlyValidators := append(
+			slices.Clone(attr.Validators),
+			stringvalidator.AlsoRequires(path.MatchRoot(versionName)),
+		)
+
+		attr.Validators = slices.Clone(attr.Validators)
+		if attr.Required {
+			attr.Required = false
+			attr.Optional = true
+			attr.Validators = append(attr.Validators, stringvalidator.ExactlyOneOf(path.MatchRoot(writeOnlyName)))
+		} else {
+			attr.Validators = append(attr.Validators, stringvalidator.ConflictsWith(path.MatchRoot(writeOnlyName)))
+		}
+
+		result[name] = attr
+		result[writeOnlyName] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf(
+				"Write-only variant of `%s`. The value is sent to Uptime Kuma but never stored in the "+
+					"Terraform state. Requires `%s`.",
+				name, versionName,
+			),
+			Optional:   true,
+			Sensitive:  true,
+			WriteOnly:  true,
+			Validators: writeOnlyValidators,
+		}
+		result[versionName] = schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf(
+				"Version of `%s`. Change the version to send an updated value of `%s` to Uptime Kuma.",
+				writeOnlyName, writeOnlyName,
+			),
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot(writeOnlyName)),
+			},
+		}
+	}
+
+	return result
+}
+
+// Schema returns the schema of the wrapped resource extended with the
+// write-only attributes.
+func (r *writeOnlySecretsResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	r.Resource.Schema(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Schema.Attributes = withWriteOnlySecretAttributes(
+		resp.Schema.Attributes,
+		writeOnlySecretNames(resp.Schema.Attributes),
+	)
+}
+
+// Configure configures the wrapped resource.
+func (r *writeOnlySecretsResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	inner, ok := r.Resource.(resource.ResourceWithConfigure)
+	if ok {
+		inner.Configure(ctx, req, resp)
+	}
+}
+
+// ConfigValidators returns the config validators of the wrapped resource.
+func (r *writeOnlySecretsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	inner, ok := r.Resource.(resource.ResourceWithConfigValidators)
+	if !ok {
+		return nil
+	}
+
+	validators := inner.ConfigValidators(ctx)
+	wrapped := make([]resource.ConfigValidator, 0, len(validators))
+
+	for _, v := range validators {
+		wrapped = append(wrapped, writeOnlySecretsConfigValidator{ConfigValidator: v, resource: r})
+	}
+
+	return wrapped
+}
+
+// writeOnlySecretsConfigValidator passes the configuration with the
+// write-only values in place of the secrets to the wrapped config validator.
+type writeOnlySecretsConfigValidator struct {
+	resource.ConfigValidator
+
+	resource *writeOnlySecretsResource
+}
+
+// ValidateResource validates the configuration through the wrapped config validator.
+func (v writeOnlySecretsConfigValidator) ValidateResource(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	conv := v.resource.converter(ctx, &resp.Diagnostics)
+	writeOnly := conv.writeOnlyValues(req.Config.Raw, &resp.Diagnostics)
+
+	innerReq := req
+	innerReq.Config = tfsdk.Config{Schema: conv.inner, Raw: conv.toInner(req.Config.Raw, writeOnly, &resp.Diagnostics)}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v.ConfigValidator.ValidateResource(ctx, innerReq, resp)
+}
+
+// Create creates the resource with the write-only values in place of the secrets.
+func (r *writeOnlySecretsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	conv := r.converter(ctx, &resp.Diagnostics)
+	writeOnly := conv.writeOnlyValues(req.Config.Raw, &resp.Diagnostics)
+	restore := conv.restoreValues(req.Plan.Raw, writeOnly, &resp.Diagnostics)
+
+	innerReq := req
+	innerReq.Config = tfsdk.Config{Schema: conv.inner, Raw: conv.toInner(req.Config.Raw, writeOnly, &resp.Diagnostics)}
+	innerReq.Plan = tfsdk.Plan{Schema: conv.inner, Raw: conv.toInner(req.Plan.Raw, writeOnly, &resp.Diagnostics)}
+
+	innerResp := resource.CreateResponse{
+		State:    tfsdk.State{Schema: conv.inner, Raw: conv.toInner(resp.State.Raw, nil, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Create(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	resp.State.Raw = conv.toOuter(innerResp.State.Raw, restore, &resp.Diagnostics)
+}
+
+// Read reads the resource and keeps secrets configured through their
+// write-only variant out of the state.
+func (r *writeOnlySecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conv := r.converter(ctx, &resp.Diagnostics)
+	writeOnly := conv.writeOnlyInState(req.State.Raw, &resp.Diagnostics)
+	restore := conv.restoreValues(req.State.Raw, writeOnly, &resp.Diagnostics)
+
+	innerReq := req
+	innerReq.State = tfsdk.State{Schema: conv.inner, Raw: conv.toInner(req.State.Raw, nil, &resp.Diagnostics)}
+
+	innerResp := resource.ReadResponse{
+		State:    tfsdk.State{Schema: conv.inner, Raw: conv.toInner(resp.State.Raw, nil, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Read(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+	resp.State.Raw = conv.toOuter(innerResp.State.Raw, restore, &resp.Diagnostics)
+}
+
+// Update updates the resource with the write-only values in place of the secrets.
+func (r *writeOnlySecretsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	conv := r.converter(ctx, &resp.Diagnostics)
+	writeOnly := conv.writeOnlyValues(req.Config.Raw, &resp.Diagnostics)
+	restore := conv.restoreValues(req.Plan.Raw, writeOnly, &resp.Diagnostics)
+
+	innerReq := req
+	innerReq.Config = tfsdk.Config{Schema: conv.inner, Raw: conv.toInner(req.Config.Raw, writeOnly, &resp.Diagnostics)}
+	innerReq.Plan = tfsdk.Plan{Schema: conv.inner, Raw: conv.toInner(req.Plan.Raw, writeOnly, &resp.Diagnostics)}
+	innerReq.State = tfsdk.State{Schema: conv.inner, Raw: conv.toInner(req.State.Raw, nil, &resp.Diagnostics)}
+
+	innerResp := resource.UpdateResponse{
+		State:    tfsdk.State{Schema: conv.inner, Raw: conv.toInner(resp.State.Raw, writeOnly, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Update(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	resp.State.Raw = conv.toOuter(innerResp.State.Raw, restore, &resp.Diagnostics)
+}
+
+// Delete deletes the resource.
+func (r *writeOnlySecretsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	conv := r.converter(ctx, &resp.Diagnostics)
+
+	innerReq := req
+	innerReq.State = tfsdk.State{Schema: conv.inner, Raw: conv.toInner(req.State.Raw, nil, &resp.Diagnostics)}
+
+	innerResp := resource.DeleteResponse{
+		State:    tfsdk.State{Schema: conv.inner, Raw: conv.toInner(resp.State.Raw, nil, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Delete(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	resp.State.Raw = conv.toOuter(innerResp.State.Raw, nil, &resp.Diagnostics)
+}
+
+// ImportState imports the resource through the wrapped resource.
+func (r *writeOnlySecretsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	inner, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import.",
+		)
+
+		return
+	}
+
+	conv := r.converter(ctx, &resp.Diagnostics)
+
+	innerResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: conv.inner, Raw: conv.toInner(resp.State.Raw, nil, &resp.Diagnostics)},
+		Identity: resp.Identity,
+		Private:  resp.Private,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ImportState(ctx, req, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+	resp.State.Raw = conv.toOuter(innerResp.State.Raw, nil, &resp.Diagnostics)
+}
+
+// ValidateConfig validates the configuration through the wrapped resource.
+func (r *writeOnlySecretsResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	inner, ok := r.Resource.(resource.ResourceWithValidateConfig)
+	if !ok {
+		return
+	}
+
+	conv := r.converter(ctx, &resp.Diagnostics)
+	writeOnly := conv.writeOnlyValues(req.Config.Raw, &resp.Diagnostics)
+
+	innerReq := req
+	innerReq.Config = tfsdk.Config{Schema: conv.inner, Raw: conv.toInner(req.Config.Raw, writeOnly, &resp.Diagnostics)}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ValidateConfig(ctx, innerReq, resp)
+}
+
+// ModifyPlan modifies the plan through the wrapped resource.
+func (r *writeOnlySecretsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	inner, ok := r.Resource.(resource.ResourceWithModifyPlan)
+	if !ok {
+		return
+	}
+
+	conv := r.converter(ctx, &resp.Diagnostics)
+	writeOnly := conv.writeOnlyValues(req.Config.Raw, &resp.Diagnostics)
+	restore := conv.restoreValues(req.Plan.Raw, writeOnly, &resp.Diagnostics)
+
+	innerReq := req
+	innerReq.Config = tfsdk.Config{Schema: conv.inner, Raw: conv.toInner(req.Config.Raw, writeOnly, &resp.Diagnostics)}
+	innerReq.Plan = tfsdk.Plan{Schema: conv.inner, Raw: conv.toInner(req.Plan.Raw, writeOnly, &resp.Diagnostics)}
+	innerReq.State = tfsdk.State{Schema: conv.inner, Raw: conv.toInner(req.State.Raw, nil, &resp.Diagnostics)}
+
+	innerResp := resource.ModifyPlanResponse{
+		Plan:            tfsdk.Plan{Schema: conv.inner, Raw: conv.toInner(resp.Plan.Raw, writeOnly, &resp.Diagnostics)},
+		Identity:        resp.Identity,
+		RequiresReplace: resp.RequiresReplace,
+		Private:         resp.Private,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ModifyPlan(ctx, innerReq, &innerResp)
+
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Identity = innerResp.Identity
+	resp.RequiresReplace = innerResp.RequiresReplace
+	resp.Private = innerResp.Private
+	resp.Deferred = innerResp.Deferred
+	resp.Plan.Raw = conv.toOuter(innerResp.Plan.Raw, restore, &resp.Diagnostics)
+}
+
+// MoveState returns the state movers of the wrapped resource. The source
+// schema of each mover is extended with the write-only attributes of the
+// source resource, so the `<name>_wo_version` attributes are carried over.
+func (r *writeOnlySecretsResource) MoveState(ctx context.Context) []resource.StateMover {
+	inner, ok := r.Resource.(resource.ResourceWithMoveState)
+	if !ok {
+		return nil
+	}
+
+	innerMovers := inner.MoveState(ctx)
+	movers := make([]resource.StateMover, 0, len(innerMovers))
+
+	for _, mover := range innerMovers {
+		movers = append(movers, r.moveStateMover(ctx, mover))
+	}
+
+	return movers
+}
+
+// moveStateMover wraps a state mover of the wrapped resource.
+func (r *writeOnlySecretsResource) moveStateMover(ctx context.Context, mover resource.StateMover) resource.StateMover {
+	var (
+		sourceSchema    *schema.Schema
+		sourceInnerType tftypes.Type
+	)
+
+	if mover.SourceSchema != nil {
+		outer := *mover.SourceSchema
+		outer.Attributes = withWriteOnlySecretAttributes(
+			outer.Attributes,
+			writeOnlySecretNames(mover.SourceSchema.Attributes),
+		)
+
+		sourceSchema = &outer
+		sourceInnerType = mover.SourceSchema.Type().TerraformType(ctx)
+	}
+
+	return resource.StateMover{
+		SourceSchema: sourceSchema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			conv := r.converter(ctx, &resp.Diagnostics)
+
+			var source tftypes.Value
+
+			innerReq := req
+			if req.SourceState != nil && mover.SourceSchema != nil {
+				source = req.SourceState.Raw
+				innerReq.SourceState = &tfsdk.State{
+					Schema: *mover.SourceSchema,
+					Raw:    convertObjectValue(source, sourceInnerType, nil, &resp.Diagnostics),
+				}
+			}
+
+			innerResp := resource.MoveStateResponse{
+				TargetState:    tfsdk.State{Schema: conv.inner, Raw: tftypes.NewValue(conv.innerType, nil)},
+				TargetIdentity: resp.TargetIdentity,
+				TargetPrivate:  resp.TargetPrivate,
+			}
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			mover.StateMover(ctx, innerReq, &innerResp)
+
+			resp.Diagnostics.Append(innerResp.Diagnostics...)
+			resp.TargetIdentity = innerResp.TargetIdentity
+			resp.TargetPrivate = innerResp.TargetPrivate
+
+			if innerResp.TargetState.Raw.IsNull() {
+				return
+			}
+
+			restore := conv.restoreValues(source, conv.writeOnlyInState(source, &resp.Diagnostics), &resp.Diagnostics)
+			resp.TargetState.Raw = conv.toOuter(innerResp.TargetState.Raw, restore, &resp.Diagnostics)
+		},
+	}
+}
+
+// converter returns the converter between the schema of the wrapped
+// resource and the extended schema.
+func (r *writeOnlySecretsResource) converter(ctx context.Context, diags *diag.Diagnostics) writeOnlySecretsConverter {
+	schemaResp := resource.SchemaResponse{}
+	r.Resource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	diags.Append(schemaResp.Diagnostics...)
+
+	secrets := writeOnlySecretNames(schemaResp.Schema.Attributes)
+
+	outer := schemaResp.Schema
+	outer.Attributes = withWriteOnlySecretAttributes(outer.Attributes, secrets)
+
+	return writeOnlySecretsConverter{
+		inner:     schemaResp.Schema,
+		innerType: schemaResp.Schema.Type().TerraformType(ctx),
+		outerType: outer.Type().TerraformType(ctx),
+		secrets:   secrets,
+	}
+}
+
+// writeOnlySecretsConverter converts values between the schema of the
+// wrapped resource and the schema extended with the write-only attributes.
+type writeOnlySecretsConverter struct {
+	inner     schema.Schema
+	innerType tftypes.Type
+	outerType tftypes.Type
+	secrets   []string
+}
+
+// writeOnlyValues returns the configured write-only values by the name of
+// the secret they replace.
+func (c writeOnlySecretsConverter) writeOnlyValues(
+	config tftypes.Value,
+	diags *diag.Diagnostics,
+) map[string]tftypes.Value {
+	attrs := objectAttributes(config, diags)
+	values := map[string]tftypes.Value{}
+
+	for _, name := range c.secrets {
+		value, ok := attrs[name+writeOnlySuffix]
+		if ok && !value.IsNull() {
+			values[name] = value
+		}
+	}
+
+	return values
+}
+
+// writeOnlyInState returns the secrets, which are managed through their
+// write-only variant according to the version stored in the state.
+func (c writeOnlySecretsConverter) writeOnlyInState(
+	state tftypes.Value,
+	diags *diag.Diagnostics,
+) map[string]tftypes.Value {
+	attrs := objectAttributes(state, diags)
+	values := map[string]tftypes.Value{}
+
+	for _, name := range c.secrets {
+		version, ok := attrs[name+writeOnlyVersionSuffix]
+		if ok && !version.IsNull() {
+			values[name] = version
+		}
+	}
+
+	return values
+}
+
+// restoreValues returns the values of the write-only related attributes from
+// source, which must be carried over to the resulting value. For secrets
+// managed through their write-only variant, this includes the (null) value
+// of the secret itself.
+func (c writeOnlySecretsConverter) restoreValues(
+	source tftypes.Value,
+	writeOnly map[string]tftypes.Value,
+	diags *diag.Diagnostics,
+) map[string]tftypes.Value {
+	attrs := objectAttributes(source, diags)
+	values := map[string]tftypes.Value{}
+
+	for _, name := range c.secrets {
+		value, ok := attrs[name+writeOnlyVersionSuffix]
+		if ok {
+			values[name+writeOnlyVersionSuffix] = value
+		}
+
+		_, managed := writeOnly[name]
+		secret, ok := attrs[name]
+		if managed && ok {
+			values[name] = secret
+		}
+	}
+
+	return values
+}
+
+// toInner converts a value of the extended schema into a value of the schema
+// of the wrapped resource, replacing attributes with the given values.
+func (c writeOnlySecretsConverter) toInner(
+	value tftypes.Value,
+	replace map[string]tftypes.Value,
+	diags *diag.Diagnostics,
+) tftypes.Value {
+	return convertObjectValue(value, c.innerType, replace, diags)
+}
+
+// toOuter converts a value of the schema of the wrapped resource into a value
+// of the extended schema, replacing attributes with the given values.
+func (c writeOnlySecretsConverter) toOuter(
+	value tftypes.Value,
+	replace map[string]tftypes.Value,
+	diags *diag.Diagnostics,
+) tftypes.Value {
+	return convertObjectValue(value, c.outerType, replace, diags)
+}
+
+// objectAttributes returns the attributes of an object value. Null and
+// unknown values have no attributes.
+func objectAttributes(value tftypes.Value, diags *diag.Diagnostics) map[string]tftypes.Value {
+	attrs := map[string]tftypes.Value{}
+	if value.Type() == nil || value.IsNull() || !value.IsKnown() {
+		return attrs
+	}
+
+	err := value.As(&attrs)
+	if err != nil {
+		diags.AddError("failed to convert write-only attributes", err.Error())
+	}
+
+	return attrs
+}
+
+// convertObjectValue converts an object value into an object value of the
+// given type. Attributes missing in value are null, attributes not present
+// in typ are dropped.
+func convertObjectValue(
+	value tftypes.Value,
+	typ tftypes.Type,
+	replace map[string]tftypes.Value,
+	diags *diag.Diagnostics,
+) tftypes.Value {
+	if value.Type() == nil || value.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	objectType, ok := typ.(tftypes.Object)
+	if !ok {
+		diags.AddError("failed to convert write-only attributes", fmt.Sprintf("unexpected type %s", typ))
+		return tftypes.NewValue(typ, nil)
+	}
+
+	attrs := objectAttributes(value, diags)
+	result := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attrType := range objectType.AttributeTypes {
+		attr, ok := replace[name]
+		if !ok {
+			attr, ok = attrs[name]
+		}
+
+		if !ok {
+			attr = tftypes.NewValue(attrType, nil)
+		}
+
+		result[name] = attr
+	}
+
+	return tftypes.NewValue(typ, result)
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderSchemaValid(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+
+	resp, err := server.GetProviderSchema(t.Context(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error: %v", err)
+	}
+
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Errorf("GetProviderSchema() diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	slack := resp.ResourceSchemas["uptimekuma_notification_slack"]
+	if slack == nil {
+		t.Fatal("missing schema for uptimekuma_notification_slack")
+	}
+
+	attrs := map[string]*tfprotov6.SchemaAttribute{}
+	for _, attr := range slack.Block.Attributes {
+		attrs[attr.Name] = attr
+	}
+
+	if attrs["webhook_url"] == nil || attrs["webhook_url"].Required {
+		t.Errorf("expected webhook_url to be optional, got %+v", attrs["webhook_url"])
+	}
+
+	if attrs["webhook_url_wo"] == nil || !attrs["webhook_url_wo"].WriteOnly {
+		t.Errorf("expected write-only webhook_url_wo, got %+v", attrs["webhook_url_wo"])
+	}
+
+	if attrs["webhook_url_wo_version"] == nil {
+		t.Error("missing webhook_url_wo_version")
+	}
+
+	// The `sasl` attribute conflicts with the write-only variant of `sasl_options`.
+	kafka := resp.ResourceSchemas["uptimekuma_monitor_kafka_producer"]
+	if kafka == nil {
+		t.Fatal("missing schema for uptimekuma_monitor_kafka_producer")
+	}
+
+	if !slices.ContainsFunc(kafka.Block.Attributes, func(attr *tfprotov6.SchemaAttribute) bool {
+		return attr.Name == "sasl_options_wo" && attr.WriteOnly
+	}) {
+		t.Error("missing write-only sasl_options_wo")
+	}
+
+	if resp.ResourceSchemas["uptimekuma_tag"] == nil {
+		t.Fatal("missing schema for uptimekuma_tag")
+	}
+}
+
+type writeOnlyTestResource struct {
+	created string
+	read    string
+}
+
+type writeOnlyTestResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Token types.String `tfsdk:"token"`
+}
+
+func (*writeOnlyTestResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "test"
+}
+
+func (*writeOnlyTestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":    schema.StringAttribute{Computed: true},
+			"token": schema.StringAttribute{Required: true, Sensitive: true},
+		},
+	}
+}
+
+func (r *writeOnlyTestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data writeOnlyTestResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	r.created = data.Token.ValueString()
+	data.ID = types.StringValue("1")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *writeOnlyTestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data writeOnlyTestResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	data.Token = types.StringValue(r.read)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (*writeOnlyTestResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (*writeOnlyTestResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func TestWriteOnlySecretsResource(t *testing.T) {
+	ctx := t.Context()
+	inner := &writeOnlyTestResource{read: "from-server"}
+	r := &writeOnlySecretsResource{Resource: inner}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	sch := schemaResp.Schema
+	typ := sch.Type().TerraformType(ctx)
+	objectValue := func(id, token, tokenWO, version tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"id":               id,
+			"token":            token,
+			"token_wo":         tokenWO,
+			"token_wo_version": version,
+		})
+	}
+
+	nullString := tftypes.NewValue(tftypes.String, nil)
+	version := tftypes.NewValue(tftypes.Number, 1)
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: sch, Raw: tftypes.NewValue(typ, nil)}}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{
+			Schema: sch,
+			Raw:    objectValue(nullString, nullString, tftypes.NewValue(tftypes.String, "secret"), version),
+		},
+		Plan: tfsdk.Plan{
+			Schema: sch,
+			Raw: objectValue(
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				nullString,
+				nullString,
+				version,
+			),
+		},
+	}, &createResp)
+
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() diagnostics: %v", createResp.Diagnostics)
+	}
+
+	if inner.created != "secret" {
+		t.Errorf("expected wrapped resource to receive the write-only value, got %q", inner.created)
+	}
+
+	expected := objectValue(tftypes.NewValue(tftypes.String, "1"), nullString, nullString, version)
+	if !createResp.State.Raw.Equal(expected) {
+		t.Errorf("Create() state = %s, want %s", createResp.State.Raw, expected)
+	}
+
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() diagnostics: %v", readResp.Diagnostics)
+	}
+
+	if !readResp.State.Raw.Equal(expected) {
+		t.Errorf("Read() state = %s, want %s", readResp.State.Raw, expected)
+	}
+
+	// Without a write-only version, the secret read from the server is kept in the state.
+	nullNumber := tftypes.NewValue(tftypes.Number, nil)
+	plain := objectValue(tftypes.NewValue(tftypes.String, "1"), nullString, nullString, nullNumber)
+	readResp = resource.ReadResponse{State: tfsdk.State{Schema: sch, Raw: plain}}
+	r.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: sch, Raw: plain}}, &readResp)
+
+	var token types.String
+
+	readResp.Diagnostics.Append(readResp.State.GetAttribute(ctx, path.Root("token"), &token)...)
+	if token.ValueString() != "from-server" {
+		t.Errorf("expected token read from server, got %s", token)
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/idna"
+
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+)
+
+// statusPageDomainValidator validates, that the value is a hostname in the
+// form Uptime Kuma matches the host of requests against.
+type statusPageDomainValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (statusPageDomainValidator) Description(_ context.Context) string {
+	return "value must be a lowercase hostname without scheme, port or path, IDNs in punycode"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v statusPageDomainValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks, that the domain is a normalized hostname.
+func (statusPageDomainValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	domain := req.ConfigValue.ValueString()
+
+	normalized, err := normalizeStatusPageDomain(domain)
+	// Handle error.
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Domain Name",
+			fmt.Sprintf("Domain name %q is invalid: %s.", domain, err),
+		)
+
+		return
+	}
+
+	if normalized != domain {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Domain Name",
+			fmt.Sprintf("Domain name %q is not normalized, use %q instead.", domain, normalized),
+		)
+	}
+}
+
+// normalizeStatusPageDomain returns the domain as lowercase hostname, with
+// IDNs converted to punycode.
+func normalizeStatusPageDomain(domain string) (string, error) {
+	switch {
+	case strings.Contains(domain, "://"):
+		return "", errors.New("must not contain a scheme")
+	case strings.ContainsAny(domain, "/?#"):
+		return "", errors.New("must not contain a path")
+	case strings.Contains(domain, ":"):
+		return "", errors.New("must not contain a port")
+	}
+
+	normalized, err := idna.Lookup.ToASCII(domain)
+	// Handle error.
+	if err != nil {
+		return "", fmt.Errorf("invalid hostname: %w", err)
+	}
+
+	return normalized, nil
+}
+
+// statusPageDomainOwners returns the slugs of the status pages other than the
+// status page with the given slug by their normalized domain names.
+func statusPageDomainOwners(statusPages map[int64]statuspage.StatusPage, slug string) map[string]string {
+	owners := map[string]string{}
+
+	for _, sp := range statusPages {
+		if sp.Slug == slug {
+			continue
+		}
+
+		for _, domain := range sp.DomainNameList {
+			normalized, err := normalizeStatusPageDomain(domain)
+			if err != nil {
+				normalized = strings.ToLower(domain)
+			}
+
+			owners[normalized] = sp.Slug
+		}
+	}
+
+	return owners
+}
+
+// checkStatusPageDomains fails the plan, if a domain of `domain_name_list` is
+// already used by another status page. Uptime Kuma moves the domain to the
+// status page saved last.
+func (r *StatusPageResource) checkStatusPageDomains(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var (
+		slug           types.String
+		domainNameList types.List
+	)
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("slug"), &slug)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain_name_list"), &domainNameList)...)
+
+	if resp.Diagnostics.HasError() || !isKnown(slug) || !isKnown(domainNameList) {
+		return
+	}
+
+	var domains []types.String
+
+	resp.Diagnostics.Append(domainNameList.ElementsAs(ctx, &domains, false)...)
+	if resp.Diagnostics.HasError() || len(domains) == 0 {
+		return
+	}
+
+	statusPages, err := r.providerData.client.GetStatusPages(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read status pages", err.Error())
+		return
+	}
+
+	owners := statusPageDomainOwners(statusPages, slug.ValueString())
+
+	for i, domain := range domains {
+		if !isKnown(domain) {
+			continue
+		}
+
+		owner, ok := owners[domain.ValueString()]
+		if !ok {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domain_name_list").AtListIndex(i),
+			"Domain Name Already In Use",
+			fmt.Sprintf("Domain name %q is already used by status page %q.", domain.ValueString(), owner),
+		)
+	}
+}
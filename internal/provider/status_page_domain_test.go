@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+)
+
+func TestStatusPageDomainValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "hostname", value: types.StringValue("status.example.com")},
+		{name: "punycode", value: types.StringValue("status.xn--bcher-kva.example")},
+		{name: "uppercase", value: types.StringValue("Status.Example.com"), wantErr: true},
+		{name: "unicode", value: types.StringValue("status.bücher.example"), wantErr: true},
+		{name: "scheme", value: types.StringValue("https://status.example.com"), wantErr: true},
+		{name: "path", value: types.StringValue("status.example.com/page"), wantErr: true},
+		{name: "port", value: types.StringValue("status.example.com:3001"), wantErr: true},
+		{name: "invalid", value: types.StringValue("status example.com"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("domain_name_list").AtListIndex(0),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			statusPageDomainValidator{}.ValidateString(t.Context(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("expected error: %t, got diagnostics: %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestNormalizeStatusPageDomain(t *testing.T) {
+	normalized, err := normalizeStatusPageDomain("Status.Bücher.example")
+	if err != nil || normalized != "status.xn--bcher-kva.example" {
+		t.Errorf("normalizeStatusPageDomain() = %q, %v, want %q", normalized, err, "status.xn--bcher-kva.example")
+	}
+}
+
+func TestStatusPageDomainOwners(t *testing.T) {
+	statusPages := map[int64]statuspage.StatusPage{
+		1: {Slug: "mine", DomainNameList: []string{"mine.example.com"}},
+		2: {Slug: "other", DomainNameList: []string{"Other.example.com", "status.bücher.example"}},
+	}
+
+	owners := statusPageDomainOwners(statusPages, "mine")
+
+	want := map[string]string{
+		"other.example.com":            "other",
+		"status.xn--bcher-kva.example": "other",
+	}
+	if fmt.Sprint(owners) != fmt.Sprint(want) {
+		t.Errorf("statusPageDomainOwners() = %v, want %v", owners, want)
+	}
+}
+
+func TestAccStatusPageResourceDomainConflict(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-domain")
+	domain := slug + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageResourceConfigWithDomain(slug, domain, false),
+			},
+			{
+				Config:      testAccStatusPageResourceConfigWithDomain(slug, domain, true),
+				ExpectError: regexp.MustCompile(`Domain Name Already In Use`),
+			},
+		},
+	})
+}
+
+func testAccStatusPageResourceConfigWithDomain(slug string, domain string, withOther bool) string {
+	config := providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_status_page" "test" {
+  slug             = %[1]q
+  title            = "Status Page with Domain"
+  domain_name_list = [%[2]q]
+}
+`, slug, domain)
+
+	if withOther {
+		config += fmt.Sprintf(`
+resource "uptimekuma_status_page" "other" {
+  slug             = "%[1]s-other"
+  title            = "Other Status Page"
+  domain_name_list = [%[2]q]
+}
+`, slug, domain)
+	}
+
+	return config
+}
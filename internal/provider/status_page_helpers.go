@@ -2,12 +2,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
+	"github.com/breml/go-uptime-kuma-client/monitor"
 	"github.com/breml/go-uptime-kuma-client/statuspage"
+	"github.com/breml/go-uptime-kuma-client/tag"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
 )
 
 // mergeGroupIDsIntoPlan preserves the plan's public_group_list values and only
@@ -58,16 +66,430 @@ func nullGroupList() types.List {
 func groupListAttrType() types.ObjectType {
 	return types.ObjectType{
 		AttrTypes: map[string]attr.Type{
-			"id":     types.Int64Type,
-			"name":   types.StringType,
-			"weight": types.Int64Type,
-			"monitor_list": types.ListType{ElemType: types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"id":       types.Int64Type,
-					"send_url": types.BoolType,
-					"url":      types.StringType,
-				},
-			}},
+			"id":                   types.Int64Type,
+			"name":                 types.StringType,
+			"weight":               types.Int64Type,
+			"monitor_list":         types.ListType{ElemType: publicMonitorAttrType()},
+			"monitor_selector":     types.ObjectType{AttrTypes: monitorSelectorAttrTypes()},
+			"selected_monitor_ids": types.ListType{ElemType: types.Int64Type},
 		},
 	}
 }
+
+func publicMonitorAttrType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":       types.Int64Type,
+			"send_url": types.BoolType,
+			"url":      types.StringType,
+		},
+	}
+}
+
+func monitorSelectorAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"tag_id":    types.Int64Type,
+		"tag_name":  types.StringType,
+		"tag_value": types.StringType,
+		"type":      types.StringType,
+		"parent":    types.Int64Type,
+	}
+}
+
+// monitorSelectorValidator validates, that a monitor selector has at least
+// one criterion and that `tag_value` is only used together with a tag.
+type monitorSelectorValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (monitorSelectorValidator) Description(_ context.Context) string {
+	return "at least one of tag_id, tag_name, type or parent must be set, tag_value requires tag_id or tag_name"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (monitorSelectorValidator) MarkdownDescription(_ context.Context) string {
+	return "at least one of `tag_id`, `tag_name`, `type` or `parent` must be set, " +
+		"`tag_value` requires `tag_id` or `tag_name`"
+}
+
+// ValidateObject checks the criteria of the monitor selector.
+func (monitorSelectorValidator) ValidateObject(
+	ctx context.Context,
+	req validator.ObjectRequest,
+	resp *validator.ObjectResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var selector MonitorSelectorModel
+
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, &selector, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasTag := !selector.TagID.IsNull() || !selector.TagName.IsNull()
+
+	if !hasTag && selector.Type.IsNull() && selector.Parent.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Monitor Selector",
+			"At least one of tag_id, tag_name, type or parent must be set.",
+		)
+
+		return
+	}
+
+	if !hasTag && !selector.TagValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("tag_value"),
+			"Invalid Monitor Selector",
+			"tag_value requires tag_id or tag_name to be set.",
+		)
+	}
+}
+
+// monitorSelectorResolver resolves the monitor selectors of public groups.
+// The tags and monitors are only read once and only if a group has a
+// monitor selector.
+type monitorSelectorResolver struct {
+	providerData *providerData
+
+	loaded   bool
+	tags     []tag.Tag
+	monitors []monitor.Base
+}
+
+// markSelectedMonitorsUnknown marks `selected_monitor_ids` of the groups
+// with a monitor selector as unknown, so they are resolved at apply.
+func markSelectedMonitorsUnknown(ctx context.Context, groupList types.List, diags *diag.Diagnostics) types.List {
+	var groups []PublicGroupModel
+
+	diags.Append(groupList.ElementsAs(ctx, &groups, true)...)
+	if diags.HasError() {
+		return groupList
+	}
+
+	for i := range groups {
+		if groups[i].MonitorSelector.IsNull() {
+			groups[i].SelectedMonitorIDs = types.ListNull(types.Int64Type)
+			continue
+		}
+
+		groups[i].SelectedMonitorIDs = types.ListUnknown(types.Int64Type)
+	}
+
+	return buildGroupListFromModels(ctx, groups, diags)
+}
+
+// resolveGroupList sets `selected_monitor_ids` of all groups in the list. On
+// apply, only the groups are resolved, which were not resolved at plan time,
+// and a tag, which does not exist, is reported as error. At plan time, such a
+// tag results in an unknown value, as the tag might be created in the same
+// apply.
+func (r *monitorSelectorResolver) resolveGroupList(
+	ctx context.Context,
+	groupList types.List,
+	apply bool,
+	diags *diag.Diagnostics,
+) types.List {
+	var groups []PublicGroupModel
+
+	diags.Append(groupList.ElementsAs(ctx, &groups, true)...)
+	if diags.HasError() {
+		return groupList
+	}
+
+	for i := range groups {
+		if groups[i].MonitorSelector.IsNull() {
+			groups[i].SelectedMonitorIDs = types.ListNull(types.Int64Type)
+			continue
+		}
+
+		if apply && !groups[i].SelectedMonitorIDs.IsUnknown() {
+			continue
+		}
+
+		groups[i].SelectedMonitorIDs = r.resolveGroup(ctx, groups[i], apply, diags)
+		if diags.HasError() {
+			return groupList
+		}
+	}
+
+	return buildGroupListFromModels(ctx, groups, diags)
+}
+
+// resolveGroup returns the IDs of the monitors matched by the monitor selector
+// of the group, which are not listed in the monitor list of the group.
+func (r *monitorSelectorResolver) resolveGroup(
+	ctx context.Context,
+	group PublicGroupModel,
+	apply bool,
+	diags *diag.Diagnostics,
+) types.List {
+	unknown := types.ListUnknown(types.Int64Type)
+
+	if group.MonitorSelector.IsUnknown() || group.MonitorList.IsUnknown() {
+		return unknown
+	}
+
+	var selector MonitorSelectorModel
+
+	diags.Append(group.MonitorSelector.As(ctx, &selector, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return unknown
+	}
+
+	if selector.TagID.IsUnknown() || selector.TagName.IsUnknown() || selector.TagValue.IsUnknown() ||
+		selector.Type.IsUnknown() || selector.Parent.IsUnknown() {
+		return unknown
+	}
+
+	err := r.load(ctx, !selector.TagID.IsNull() || !selector.TagName.IsNull())
+	// Handle error.
+	if err != nil {
+		diags.AddError("failed to resolve monitor selector", err.Error())
+		return unknown
+	}
+
+	tagID, found := r.resolveTag(selector, diags)
+	if diags.HasError() {
+		return unknown
+	}
+
+	if !found {
+		if apply {
+			diags.AddError(
+				"Unknown Tag",
+				fmt.Sprintf(
+					"The monitor selector of the group %q references a tag, which does not exist.",
+					group.Name.ValueString(),
+				),
+			)
+		}
+
+		return unknown
+	}
+
+	var listed []PublicMonitorModel
+	if !group.MonitorList.IsNull() {
+		diags.Append(group.MonitorList.ElementsAs(ctx, &listed, false)...)
+		if diags.HasError() {
+			return unknown
+		}
+	}
+
+	monitorIDs := []int64{}
+
+	for _, mon := range r.monitors {
+		if !monitorSelectorMatches(mon, selector, tagID) {
+			continue
+		}
+
+		if slices.ContainsFunc(listed, func(m PublicMonitorModel) bool { return m.ID.ValueInt64() == mon.ID }) {
+			continue
+		}
+
+		monitorIDs = append(monitorIDs, mon.ID)
+	}
+
+	slices.Sort(monitorIDs)
+
+	selected, d := types.ListValueFrom(ctx, types.Int64Type, monitorIDs)
+	diags.Append(d...)
+
+	return selected
+}
+
+// load reads the monitors and, if withTags is true, the tags.
+func (r *monitorSelectorResolver) load(ctx context.Context, withTags bool) error {
+	if withTags && r.tags == nil {
+		tags, err := r.providerData.client.GetTags(ctx)
+		// Handle error.
+		if err != nil {
+			return fmt.Errorf("read tags: %w", err)
+		}
+
+		r.tags = tags
+	}
+
+	if r.loaded {
+		return nil
+	}
+
+	monitors, err := r.providerData.client.GetMonitors(ctx)
+	// Handle error.
+	if err != nil {
+		return fmt.Errorf("read monitors: %w", err)
+	}
+
+	r.monitors = monitors
+	r.loaded = true
+
+	return nil
+}
+
+// resolveTag returns the ID of the tag of the selector. If the selector does
+// not select by tag, it returns 0. The second return value is false, if the
+// tag does not exist.
+func (r *monitorSelectorResolver) resolveTag(selector MonitorSelectorModel, diags *diag.Diagnostics) (int64, bool) {
+	if selector.TagID.IsNull() && selector.TagName.IsNull() {
+		return 0, true
+	}
+
+	found, ok := findTag(r.tags, selector.TagID, selector.TagName, diags)
+
+	return found.ID, ok
+}
+
+// monitorSelectorMatches reports, whether the monitor matches all criteria of
+// the selector. tagID is the resolved ID of the tag of the selector.
+func monitorSelectorMatches(mon monitor.Base, selector MonitorSelectorModel, tagID int64) bool {
+	if !selector.TagID.IsNull() || !selector.TagName.IsNull() {
+		if !slices.ContainsFunc(mon.Tags, func(t tag.MonitorTag) bool {
+			return t.TagID == tagID && (selector.TagValue.IsNull() || t.Value == selector.TagValue.ValueString())
+		}) {
+			return false
+		}
+	}
+
+	if !selector.Type.IsNull() && mon.Type() != selector.Type.ValueString() {
+		return false
+	}
+
+	if !selector.Parent.IsNull() && (mon.Parent == nil || *mon.Parent != selector.Parent.ValueInt64()) {
+		return false
+	}
+
+	return true
+}
+
+// appendSelectedMonitors appends the monitors selected by the monitor
+// selector of a group to the monitors listed explicitly.
+func appendSelectedMonitors(monitors []statuspage.PublicMonitor, selected []int64) []statuspage.PublicMonitor {
+	for _, id := range selected {
+		monitors = append(monitors, statuspage.PublicMonitor{ID: id})
+	}
+
+	return monitors
+}
+
+// mergeStatusPageGroups merges groups into the current public groups of a
+// status page. Current groups with an ID in owned are replaced by the group
+// with the same ID or removed, if there is none. The other current groups are
+// kept and groups without a current group are added as new groups. Groups
+// without a weight keep the position of the current group or are added at the
+// end. It returns the merged groups ordered by weight and the index of each
+// group in them.
+func mergeStatusPageGroups(
+	current []client.PublicGroup,
+	owned []int64,
+	groups []statuspage.PublicGroup,
+) ([]statuspage.PublicGroup, []int) {
+	type entry struct {
+		group statuspage.PublicGroup
+		index int
+	}
+
+	entries := make([]entry, 0, len(current)+len(groups))
+	currentWeights := make(map[int64]int, len(current))
+	maxWeight := 0
+
+	for _, group := range current {
+		weight := int(group.Weight)
+		currentWeights[group.ID] = weight
+		maxWeight = max(maxWeight, weight)
+
+		if slices.Contains(owned, group.ID) || slices.ContainsFunc(groups, func(g statuspage.PublicGroup) bool {
+			return g.ID == group.ID
+		}) {
+			continue
+		}
+
+		entries = append(entries, entry{group: publicGroupToAPI(group), index: -1})
+	}
+
+	for i, group := range groups {
+		weight, ok := currentWeights[group.ID]
+		if !ok {
+			// Groups removed outside of Terraform are added again.
+			group.ID = 0
+		}
+
+		if group.Weight == 0 {
+			if !ok {
+				maxWeight++
+				weight = maxWeight
+			}
+
+			group.Weight = weight
+		}
+
+		entries = append(entries, entry{group: group, index: i})
+	}
+
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		return a.group.Weight - b.group.Weight
+	})
+
+	merged := make([]statuspage.PublicGroup, len(entries))
+	indices := make([]int, len(groups))
+
+	for i, e := range entries {
+		merged[i] = e.group
+		if e.index >= 0 {
+			indices[e.index] = i
+		}
+	}
+
+	return merged, indices
+}
+
+// publicGroupToAPI converts a group read from the public status page API,
+// so it can be saved again unchanged.
+func publicGroupToAPI(group client.PublicGroup) statuspage.PublicGroup {
+	monitors := make([]statuspage.PublicMonitor, len(group.MonitorList))
+
+	for i, mon := range group.MonitorList {
+		sendURL := mon.SendURL
+		monitors[i] = statuspage.PublicMonitor{ID: mon.ID, SendURL: &sendURL}
+
+		if mon.URL != "" {
+			url := mon.URL
+			monitors[i].URL = &url
+		}
+	}
+
+	return statuspage.PublicGroup{
+		ID:          group.ID,
+		Name:        group.Name,
+		Weight:      int(group.Weight),
+		MonitorList: monitors,
+	}
+}
+
+// publicGroupIDs returns the known IDs of the groups in the given group lists.
+func publicGroupIDs(ctx context.Context, diags *diag.Diagnostics, groupLists ...types.List) []int64 {
+	var ids []int64
+
+	for _, groupList := range groupLists {
+		if groupList.IsNull() || groupList.IsUnknown() {
+			continue
+		}
+
+		var groups []PublicGroupModel
+
+		diags.Append(groupList.ElementsAs(ctx, &groups, false)...)
+		if diags.HasError() {
+			return nil
+		}
+
+		for _, group := range groups {
+			if isKnown(group.ID) && !slices.Contains(ids, group.ID.ValueInt64()) {
+				ids = append(ids, group.ID.ValueInt64())
+			}
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // Register the GIF decoder for icons.
+	_ "image/jpeg" // Register the JPEG decoder for icons.
+	"image/png"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+
+	"github.com/breml/terraform-provider-uptimekuma/internal/client"
+)
+
+// statusPageIconDataURIPrefix is the prefix of the data URIs, which Uptime
+// Kuma stores as icon file. Uptime Kuma only accepts PNG icons.
+const statusPageIconDataURIPrefix = "data:image/png;base64,"
+
+// loadStatusPageIcon loads the icon from source, which is either a file path
+// or the base64 encoded content of the icon, e.g. from `filebase64()`. JPEG
+// and GIF icons are converted to PNG. It returns the icon in PNG format.
+func loadStatusPageIcon(source string) ([]byte, error) {
+	content, err := base64.StdEncoding.DecodeString(source)
+	if err != nil || !isStatusPageIconContent(content) {
+		content, err = os.ReadFile(source)
+		// Handle error.
+		if err != nil {
+			return nil, fmt.Errorf("read icon: %w", err)
+		}
+	}
+
+	contentType := http.DetectContentType(content)
+
+	switch {
+	case contentType == "image/png":
+		_, err = png.DecodeConfig(bytes.NewReader(content))
+		// Handle error.
+		if err != nil {
+			return nil, fmt.Errorf("invalid PNG icon: %w", err)
+		}
+
+		return content, nil
+
+	case contentType == "image/jpeg" || contentType == "image/gif":
+		img, _, err := image.Decode(bytes.NewReader(content))
+		// Handle error.
+		if err != nil {
+			return nil, fmt.Errorf("invalid icon of type %s: %w", contentType, err)
+		}
+
+		var buf bytes.Buffer
+
+		err = png.Encode(&buf, img)
+		// Handle error.
+		if err != nil {
+			return nil, fmt.Errorf("convert icon to PNG: %w", err)
+		}
+
+		return buf.Bytes(), nil
+
+	case isSVG(content):
+		return nil, errors.New("SVG icons are not supported by Uptime Kuma, convert the icon to PNG")
+
+	default:
+		return nil, fmt.Errorf("unsupported icon of type %s, supported are PNG, JPEG and GIF", contentType)
+	}
+}
+
+// isStatusPageIconContent reports whether content looks like an icon, which
+// tells the base64 encoded content of an icon from a file path.
+func isStatusPageIconContent(content []byte) bool {
+	switch http.DetectContentType(content) {
+	case "image/png", "image/jpeg", "image/gif":
+		return true
+	default:
+		return isSVG(content)
+	}
+}
+
+func isSVG(content []byte) bool {
+	return bytes.Contains(bytes.ToLower(content[:min(len(content), 1024)]), []byte("<svg"))
+}
+
+// statusPageIconSHA256 returns the hex encoded SHA-256 hash of the icon.
+func statusPageIconSHA256(icon []byte) string {
+	sum := sha256.Sum256(icon)
+	return hex.EncodeToString(sum[:])
+}
+
+// planIconSHA256 plans `icon_sha256` from the icon of `icon_source`.
+func planIconSHA256(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var iconSource types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("icon_source"), &iconSource)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iconSHA256 := types.StringNull()
+
+	switch {
+	case iconSource.IsUnknown():
+		iconSHA256 = types.StringUnknown()
+
+	case !iconSource.IsNull():
+		icon, err := loadStatusPageIcon(iconSource.ValueString())
+		// Handle error.
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("icon_source"), "Invalid Icon", err.Error())
+			return
+		}
+
+		iconSHA256 = types.StringValue(statusPageIconSHA256(icon))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("icon_sha256"), iconSHA256)...)
+}
+
+// applyIconSource sets the icon of sp from `icon_source` and populates
+// `icon_sha256`. The icon is only uploaded, if its hash differs from
+// stateSHA256. Otherwise the current icon of the status page is kept.
+func (r *StatusPageResource) applyIconSource(
+	ctx context.Context,
+	data *StatusPageResourceModel,
+	stateSHA256 types.String,
+	sp *statuspage.StatusPage,
+	diags *diag.Diagnostics,
+) {
+	if data.IconSource.IsNull() {
+		data.IconSHA256 = types.StringNull()
+		return
+	}
+
+	icon, err := loadStatusPageIcon(data.IconSource.ValueString())
+	if err != nil {
+		diags.AddError("failed to read icon", err.Error())
+		return
+	}
+
+	iconSHA256 := types.StringValue(statusPageIconSHA256(icon))
+	if isKnown(data.IconSHA256) && !data.IconSHA256.Equal(iconSHA256) {
+		diags.AddAttributeError(
+			path.Root("icon_source"),
+			"Inconsistent Icon",
+			"The icon changed after the plan was created. Run the plan again.",
+		)
+
+		return
+	}
+
+	data.IconSHA256 = iconSHA256
+
+	if stateSHA256.Equal(iconSHA256) {
+		current, err := r.providerData.client.GetStatusPage(ctx, data.Slug.ValueString())
+		if err != nil {
+			diags.AddError("failed to read status page", err.Error())
+			return
+		}
+
+		sp.Icon = current.Icon
+
+		return
+	}
+
+	sp.Icon = statusPageIconDataURIPrefix + base64.StdEncoding.EncodeToString(icon)
+}
+
+// readIconSHA256 returns the hash of the icon of the status page, if the
+// icon is managed by `icon_source`.
+func (r *StatusPageResource) readIconSHA256(
+	ctx context.Context,
+	data *StatusPageResourceModel,
+	sp *statuspage.StatusPage,
+	diags *diag.Diagnostics,
+) types.String {
+	if data.IconSource.IsNull() || sp.Icon == "" || r.providerData.clientConfig == nil {
+		return types.StringNull()
+	}
+
+	icon, err := client.GetStatusPageIcon(ctx, r.providerData.clientConfig, sp.Icon)
+	if err != nil {
+		// A missing icon is uploaded again.
+		if errors.Is(err, client.ErrStatusPageIconNotFound) {
+			return types.StringNull()
+		}
+
+		diags.AddError("failed to read status page icon", err.Error())
+
+		return data.IconSHA256
+	}
+
+	return types.StringValue(statusPageIconSHA256(icon))
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func testIconImage(c color.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for x := range 4 {
+		for y := range 4 {
+			img.Set(x, y, c)
+		}
+	}
+
+	return img
+}
+
+func testIconFile(t *testing.T, name string, encode func(*bytes.Buffer) error) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	err := encode(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	file := filepath.Join(t.TempDir(), name)
+
+	err = os.WriteFile(file, buf.Bytes(), 0o600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return file
+}
+
+func TestLoadStatusPageIcon(t *testing.T) {
+	img := testIconImage(color.RGBA{R: 255, A: 255})
+
+	pngFile := testIconFile(t, "icon.png", func(buf *bytes.Buffer) error { return png.Encode(buf, img) })
+	jpegFile := testIconFile(t, "icon.jpg", func(buf *bytes.Buffer) error { return jpeg.Encode(buf, img, nil) })
+	gifFile := testIconFile(t, "icon.gif", func(buf *bytes.Buffer) error { return gif.Encode(buf, img, nil) })
+	svgFile := testIconFile(t, "icon.svg", func(buf *bytes.Buffer) error {
+		_, err := buf.WriteString(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`)
+		return err
+	})
+	textFile := testIconFile(t, "icon.txt", func(buf *bytes.Buffer) error {
+		_, err := buf.WriteString("not an icon")
+		return err
+	})
+
+	pngContent, err := os.ReadFile(pngFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "png file", source: pngFile},
+		{name: "png content", source: base64.StdEncoding.EncodeToString(pngContent)},
+		{name: "jpeg file", source: jpegFile},
+		{name: "gif file", source: gifFile},
+		{name: "svg file", source: svgFile, wantErr: "SVG icons are not supported"},
+		{name: "text file", source: textFile, wantErr: "unsupported icon of type text/plain"},
+		{name: "missing file", source: filepath.Join(t.TempDir(), "missing.png"), wantErr: "read icon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			icon, err := loadStatusPageIcon(tt.source)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want error containing %q", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, err = png.Decode(bytes.NewReader(icon))
+			if err != nil {
+				t.Errorf("icon is not a PNG image: %v", err)
+			}
+		})
+	}
+
+	// PNG icons are used as is, so the hash matches the file.
+	icon, err := loadStatusPageIcon(pngFile)
+	if err != nil || statusPageIconSHA256(icon) != statusPageIconSHA256(pngContent) {
+		t.Errorf("hash of PNG icon = %s, want hash of the file", statusPageIconSHA256(icon))
+	}
+}
+
+func TestAccStatusPageResourceWithIconSource(t *testing.T) {
+	slug := acctest.RandomWithPrefix("test-icon")
+
+	red := testIconFile(t, "red.png", func(buf *bytes.Buffer) error {
+		return png.Encode(buf, testIconImage(color.RGBA{R: 255, A: 255}))
+	})
+	blue := testIconFile(t, "blue.jpg", func(buf *bytes.Buffer) error {
+		return jpeg.Encode(buf, testIconImage(color.RGBA{B: 255, A: 255}), nil)
+	})
+
+	sha256Pattern := regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageResourceConfigWithIconSource(slug, red),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page.test",
+						tfjsonpath.New("icon_sha256"),
+						knownvalue.StringRegexp(sha256Pattern),
+					),
+				},
+			},
+			{
+				Config: testAccStatusPageResourceConfigWithIconSource(slug, blue),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"uptimekuma_status_page.test",
+						tfjsonpath.New("icon_sha256"),
+						knownvalue.StringRegexp(sha256Pattern),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccStatusPageResourceConfigWithIconSource(slug string, iconSource string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "uptimekuma_status_page" "test" {
+  slug        = %[1]q
+  title       = "Status Page with Icon"
+  icon_source = %[2]q
+}
+`, slug, iconSource)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+)
+
+// statusPageSaveConfig returns the settings of the status page in the form
+// of the config argument of the saveStatusPage event. In addition to the
+// settings sent by the Uptime Kuma client library, it contains the auto
+// refresh interval of Uptime Kuma 2.x, unless autoRefreshInterval is nil.
+func statusPageSaveConfig(sp *statuspage.StatusPage, autoRefreshInterval *int64) map[string]any {
+	config := map[string]any{
+		"slug":                  sp.Slug,
+		"title":                 sp.Title,
+		"description":           sp.Description,
+		"theme":                 sp.Theme,
+		"published":             sp.Published,
+		"showTags":              sp.ShowTags,
+		"domainNameList":        sp.DomainNameList,
+		"analyticsType":         sp.AnalyticsType,
+		"analyticsId":           sp.AnalyticsID,
+		"analyticsScriptUrl":    sp.AnalyticsScriptURL,
+		"customCSS":             sp.CustomCSS,
+		"footerText":            sp.FooterText,
+		"showPoweredBy":         sp.ShowPoweredBy,
+		"showCertificateExpiry": sp.ShowCertificateExpiry,
+	}
+
+	if autoRefreshInterval != nil {
+		config["autoRefreshInterval"] = *autoRefreshInterval
+	}
+
+	return config
+}
+
+// saveStatusPage saves the status page and returns the saved groups. It is
+// used instead of the Uptime Kuma client library, which does not send the
+// auto refresh interval, so Uptime Kuma 2.x would reset it on every save.
+func (pd *providerData) saveStatusPage(
+	ctx context.Context,
+	sp *statuspage.StatusPage,
+	autoRefreshInterval *int64,
+) ([]statuspage.PublicGroup, error) {
+	session, err := pd.openSession(ctx)
+	// Handle error.
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = session.Close()
+	}()
+
+	publicGroupList := sp.PublicGroupList
+	if publicGroupList == nil {
+		publicGroupList = []statuspage.PublicGroup{}
+	}
+
+	var res struct {
+		PublicGroupList []statuspage.PublicGroup `json:"publicGroupList"`
+	}
+
+	_, err = session.Call(
+		ctx,
+		"saveStatusPage",
+		&res,
+		sp.Slug,
+		statusPageSaveConfig(sp, autoRefreshInterval),
+		sp.Icon,
+		publicGroupList,
+	)
+	// Handle error.
+	if err != nil {
+		return nil, err
+	}
+
+	return res.PublicGroupList, nil
+}
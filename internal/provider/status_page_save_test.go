@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/breml/go-uptime-kuma-client/statuspage"
+)
+
+func TestStatusPageSaveConfig(t *testing.T) {
+	sp := &statuspage.StatusPage{
+		Slug:           "test",
+		Title:          "Test",
+		Theme:          "dark",
+		DomainNameList: []string{"status.example.com"},
+	}
+
+	config := statusPageSaveConfig(sp, nil)
+	if _, ok := config["autoRefreshInterval"]; ok {
+		t.Errorf("config contains autoRefreshInterval, want it omitted")
+	}
+
+	if config["slug"] != "test" || config["title"] != "Test" || config["theme"] != "dark" {
+		t.Errorf("config = %v, want settings of the status page", config)
+	}
+
+	interval := int64(60)
+
+	config = statusPageSaveConfig(sp, &interval)
+	if config["autoRefreshInterval"] != int64(60) {
+		t.Errorf("autoRefreshInterval = %v, want 60", config["autoRefreshInterval"])
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/breml/go-uptime-kuma-client/tag"
+)
+
+// findTag returns the tag with the given ID or, if the ID is not known, the
+// tag with the given name. The second return value is false, if the tag does
+// not exist or if the name is ambiguous, which is reported as error.
+func findTag(tags []tag.Tag, id types.Int64, name types.String, diags *diag.Diagnostics) (tag.Tag, bool) {
+	if isKnown(id) {
+		idx := slices.IndexFunc(tags, func(t tag.Tag) bool { return t.ID == id.ValueInt64() })
+		if idx < 0 {
+			return tag.Tag{}, false
+		}
+
+		return tags[idx], true
+	}
+
+	if !isKnown(name) {
+		return tag.Tag{}, false
+	}
+
+	var matches []tag.Tag
+	for _, t := range tags {
+		if t.Name == name.ValueString() {
+			matches = append(matches, t)
+		}
+	}
+
+	if len(matches) > 1 {
+		diags.AddError(
+			"Ambiguous Tag Name",
+			fmt.Sprintf("There are %d tags with the name %q. Use `tag_id` instead.", len(matches), name.ValueString()),
+		)
+
+		return tag.Tag{}, false
+	}
+
+	if len(matches) == 0 {
+		return tag.Tag{}, false
+	}
+
+	return matches[0], true
+}
@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = NormalizedJSONType{}
+	_ basetypes.StringValuableWithSemanticEquals = NormalizedJSONValue{}
+	_ validator.String                           = jsonValidator{}
+)
+
+// NormalizedJSONType is a string type for attributes holding JSON. Values
+// are compared semantically, so differences in whitespace, key order or
+// number formatting, e.g. introduced by Uptime Kuma normalizing the JSON,
+// do not result in a diff. Values, which are not valid JSON, are compared
+// as plain strings.
+type NormalizedJSONType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (NormalizedJSONType) String() string {
+	return "NormalizedJSONType"
+}
+
+// ValueType returns the value type of this type.
+func (NormalizedJSONType) ValueType(_ context.Context) attr.Value {
+	return NormalizedJSONValue{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NormalizedJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(NormalizedJSONType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a NormalizedJSONValue for the given string value.
+func (NormalizedJSONType) ValueFromString(
+	_ context.Context,
+	in basetypes.StringValue,
+) (basetypes.StringValuable, diag.Diagnostics) {
+	return NormalizedJSONValue{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a NormalizedJSONValue for the given Terraform value.
+func (t NormalizedJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("convert value: %w", err)
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("convert StringValue to NormalizedJSONValue: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// NormalizedJSONValue is the value of a NormalizedJSONType attribute.
+type NormalizedJSONValue struct {
+	basetypes.StringValue
+}
+
+// NewNormalizedJSONNull returns a null NormalizedJSONValue.
+func NewNormalizedJSONNull() NormalizedJSONValue {
+	return NormalizedJSONValue{StringValue: basetypes.NewStringNull()}
+}
+
+// NewNormalizedJSONValue returns a known NormalizedJSONValue.
+func NewNormalizedJSONValue(value string) NormalizedJSONValue {
+	return NormalizedJSONValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// normalizedJSONOrNull returns a null NormalizedJSONValue for an empty string.
+func normalizedJSONOrNull(value string) NormalizedJSONValue {
+	if value == "" {
+		return NewNormalizedJSONNull()
+	}
+
+	return NewNormalizedJSONValue(value)
+}
+
+// Type returns the type of the value.
+func (NormalizedJSONValue) Type(_ context.Context) attr.Type {
+	return NormalizedJSONType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v NormalizedJSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(NormalizedJSONValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values represent the same JSON.
+func (v NormalizedJSONValue) StringSemanticEquals(
+	_ context.Context,
+	newValuable basetypes.StringValuable,
+) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NormalizedJSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	return jsonSemanticallyEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// jsonSemanticallyEqual returns true if a and b are equal strings or
+// represent the same JSON value.
+func jsonSemanticallyEqual(a string, b string) bool {
+	if a == b {
+		return true
+	}
+
+	valueA, err := decodeJSON(a)
+	if err != nil {
+		return false
+	}
+
+	valueB, err := decodeJSON(b)
+	if err != nil {
+		return false
+	}
+
+	return jsonValuesEqual(valueA, valueB)
+}
+
+// jsonDecodeError is returned by decodeJSON and holds the byte offset, at
+// which decoding failed.
+type jsonDecodeError struct {
+	offset int64
+	err    error
+}
+
+// Error returns the error message.
+func (e *jsonDecodeError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *jsonDecodeError) Unwrap() error {
+	return e.err
+}
+
+// decodeJSON decodes a single JSON value, keeping numbers as json.Number.
+func decodeJSON(value string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var result any
+
+	err := decoder.Decode(&result)
+	if err != nil {
+		offset := int64(len(value))
+
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			// The offset points behind the offending character.
+			offset = max(syntaxErr.Offset-1, 0)
+		}
+
+		return nil, &jsonDecodeError{offset: offset, err: err}
+	}
+
+	// Only whitespace may follow the value.
+	offset := decoder.InputOffset()
+	if strings.TrimSpace(value[offset:]) != "" {
+		offset += int64(len(value[offset:]) - len(strings.TrimLeft(value[offset:], " \t\r\n")))
+
+		return nil, &jsonDecodeError{offset: offset, err: errors.New("unexpected data after top-level value")}
+	}
+
+	return result, nil
+}
+
+// jsonValuesEqual compares two decoded JSON values. Numbers are compared by
+// their value, e.g. `1`, `1.0` and `1e0` are equal.
+func jsonValuesEqual(a any, b any) bool {
+	switch valueA := a.(type) {
+	case map[string]any:
+		valueB, ok := b.(map[string]any)
+		if !ok || len(valueA) != len(valueB) {
+			return false
+		}
+
+		for key, elemA := range valueA {
+			elemB, found := valueB[key]
+			if !found || !jsonValuesEqual(elemA, elemB) {
+				return false
+			}
+		}
+
+		return true
+
+	case []any:
+		valueB, ok := b.([]any)
+		if !ok || len(valueA) != len(valueB) {
+			return false
+		}
+
+		for i := range valueA {
+			if !jsonValuesEqual(valueA[i], valueB[i]) {
+				return false
+			}
+		}
+
+		return true
+
+	case json.Number:
+		valueB, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+
+		ratA, okA := new(big.Rat).SetString(valueA.String())
+		ratB, okB := new(big.Rat).SetString(valueB.String())
+
+		return okA && okB && ratA.Cmp(ratB) == 0
+
+	default:
+		return a == b
+	}
+}
+
+// jsonSyntaxError returns a description of the first syntax error in value
+// including its line and column, or an empty string for valid JSON.
+func jsonSyntaxError(value string) string {
+	_, err := decodeJSON(value)
+	if err == nil {
+		return ""
+	}
+
+	offset := int64(len(value))
+
+	var decodeErr *jsonDecodeError
+	if errors.As(err, &decodeErr) {
+		offset = decodeErr.offset
+	}
+
+	line, column := textPosition(value, offset)
+
+	return fmt.Sprintf("line %d, column %d: %s", line, column, err)
+}
+
+// textPosition returns the 1-based line and column of the byte offset in value.
+func textPosition(value string, offset int64) (int, int) {
+	line, column := 1, 1
+
+	for i, r := range value {
+		if int64(i) >= offset {
+			break
+		}
+
+		if r == '\n' {
+			line++
+			column = 1
+
+			continue
+		}
+
+		column++
+	}
+
+	return line, column
+}
+
+// jsonValidator validates, that a string contains valid JSON.
+type jsonValidator struct{}
+
+// validateJSON returns a validator, which checks that a string contains valid JSON.
+func validateJSON() validator.String {
+	return jsonValidator{}
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (jsonValidator) Description(_ context.Context) string {
+	return "string must be valid JSON"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v jsonValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks that the provided string contains valid JSON.
+func (jsonValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	msg := jsonSyntaxError(req.ConfigValue.ValueString())
+	if msg != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			fmt.Sprintf("Attribute must contain valid JSON, error at %s.", msg),
+		)
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestNormalizedJSONSemanticEquals(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "identical", a: `{"a":1}`, b: `{"a":1}`, want: true},
+		{name: "whitespace", a: `{"a":1,"b":[1,2]}`, b: "{\n  \"a\": 1,\n  \"b\": [1, 2]\n}", want: true},
+		{name: "key order", a: `{"a":1,"b":2}`, b: `{"b":2,"a":1}`, want: true},
+		{name: "number format", a: `{"a":1}`, b: `{"a":1.0e0}`, want: true},
+		{name: "large numbers", a: `12345678901234567890`, b: `12345678901234567891`, want: false},
+		{name: "different value", a: `{"a":1}`, b: `{"a":"1"}`, want: false},
+		{name: "array order", a: `[1,2]`, b: `[2,1]`, want: false},
+		{name: "additional key", a: `{"a":1}`, b: `{"a":1,"b":null}`, want: false},
+		{name: "invalid json identical", a: `a=1&b=2`, b: `a=1&b=2`, want: true},
+		{name: "invalid json different", a: `a=1&b=2`, b: `a=1&b=3`, want: false},
+		{name: "trailing data", a: `{"a":1}`, b: `{"a":1} x`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := NewNormalizedJSONValue(tt.a).StringSemanticEquals(t.Context(), NewNormalizedJSONValue(tt.b))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got != tt.want {
+				t.Fatalf("StringSemanticEquals(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONSyntaxError(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "valid", value: `{"a": [1, 2]}`},
+		{name: "valid with surrounding whitespace", value: "\n  {}\n"},
+		{name: "invalid value", value: `{"a": x}`, want: "line 1, column 7:"},
+		{name: "invalid value on second line", value: "{\n  \"a\": ,\n}", want: "line 2, column 8:"},
+		{name: "missing comma", value: "{\n  \"a\": 1\n  \"b\": 2\n}", want: "line 3, column 3:"},
+		{name: "unexpected end", value: `{"a": 1`, want: "line 1, column 8: unexpected EOF"},
+		{name: "trailing data", value: "{}\n  {}", want: "line 2, column 3: unexpected data after top-level value"},
+		{name: "empty", value: "", want: "line 1, column 1:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := jsonSyntaxError(tt.value)
+			if tt.want == "" && got != "" {
+				t.Fatalf("unexpected error: %s", got)
+			}
+
+			if tt.want != "" && !strings.HasPrefix(got, tt.want) {
+				t.Fatalf("expected error starting with %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestJSONValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "valid", value: types.StringValue(`{"Content-Type": "application/json"}`)},
+		{name: "invalid", value: types.StringValue(`{"Content-Type": }`), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("headers"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			validateJSON().ValidateString(t.Context(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("expected error: %t, got diagnostics: %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestHTTPBodyConfigValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]string
+		wantErr bool
+	}{
+		{name: "json body", config: map[string]string{"body": `{"a": 1}`}},
+		{name: "invalid json body", config: map[string]string{"body": `{"a": }`}, wantErr: true},
+		{
+			name:    "invalid json body with json encoding",
+			config:  map[string]string{"body": `a=1`, "http_body_encoding": "json"},
+			wantErr: true,
+		},
+		{name: "form body", config: map[string]string{"body": `a=1`, "http_body_encoding": "form"}},
+		{name: "xml body", config: map[string]string{"body": `<a>1</a>`, "http_body_encoding": "xml"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateResourceConfig(t, "uptimekuma_monitor_http", tt.config)
+
+			if hasErrorDiagnostic(diags, "Invalid JSON") != tt.wantErr {
+				var summaries []string
+				for _, d := range diags {
+					if d.Severity == tfprotov6.DiagnosticSeverityError {
+						summaries = append(summaries, d.Summary+": "+d.Detail)
+					}
+				}
+
+				t.Fatalf("expected error: %t, got %v", tt.wantErr, summaries)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
@@ -21,8 +23,22 @@ func main() {
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags]\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "       %s export [export flags]\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
+	if flag.Arg(0) == "export" {
+		err := runExport(context.Background(), flag.Args()[1:])
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/breml/uptimekuma",
 		Debug:   debug,